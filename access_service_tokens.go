@@ -0,0 +1,120 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AccessServiceToken is a Client ID and Client Secret pair which automated
+// systems present to applications protected by Access.
+type AccessServiceToken struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	ClientID  string     `json:"client_id"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Duration  string     `json:"duration,omitempty"`
+	// ClientSecret is only returned when the token is created or rotated and
+	// cannot be retrieved afterwards.
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// AccessServiceTokenResponse represents the response from the Access service
+// token endpoints containing a single token.
+type AccessServiceTokenResponse struct {
+	Response
+	Result AccessServiceToken `json:"result"`
+}
+
+// AccessServiceTokensListResponse represents the response from the list
+// Access service tokens endpoint.
+type AccessServiceTokensListResponse struct {
+	Response
+	Result     []AccessServiceToken `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// CreateAccessServiceToken creates a new service token in the given account.
+// The returned token holds the ClientSecret, which is not shown again.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-create-access-service-token
+func (api *API) CreateAccessServiceToken(accountID, name string) (AccessServiceToken, error) {
+	uri := "/accounts/" + accountID + "/access/service_tokens"
+	res, err := api.makeRequest("POST", uri, struct {
+		Name string `json:"name"`
+	}{name})
+	if err != nil {
+		return AccessServiceToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r AccessServiceTokenResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return AccessServiceToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// AccessServiceTokens lists all service tokens of the given account. Their
+// secrets are not included.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-list-access-service-tokens
+func (api *API) AccessServiceTokens(accountID string) ([]AccessServiceToken, error) {
+	uri := "/accounts/" + accountID + "/access/service_tokens"
+	var tokens []AccessServiceToken
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 50}, 50, func(res []byte) (ResultInfo, error) {
+		var r AccessServiceTokensListResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		tokens = append(tokens, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []AccessServiceToken{}, err
+	}
+	return tokens, nil
+}
+
+// RefreshAccessServiceToken extends the expiry of a service token by its
+// duration without changing its secret.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-refresh-a-service-token
+func (api *API) RefreshAccessServiceToken(accountID, tokenID string) (AccessServiceToken, error) {
+	return api.accessServiceTokenAction(accountID, tokenID, "refresh")
+}
+
+// RotateAccessServiceToken generates a new secret for a service token,
+// invalidating the previous one. The returned token holds the new
+// ClientSecret.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-rotate-a-service-token
+func (api *API) RotateAccessServiceToken(accountID, tokenID string) (AccessServiceToken, error) {
+	return api.accessServiceTokenAction(accountID, tokenID, "rotate")
+}
+
+// accessServiceTokenAction performs the named action on a service token.
+func (api *API) accessServiceTokenAction(accountID, tokenID, action string) (AccessServiceToken, error) {
+	uri := "/accounts/" + accountID + "/access/service_tokens/" + tokenID + "/" + action
+	res, err := api.makeRequest("POST", uri, nil)
+	if err != nil {
+		return AccessServiceToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r AccessServiceTokenResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return AccessServiceToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteAccessServiceToken deletes a service token. Systems using it lose
+// access immediately.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-delete-access-service-token
+func (api *API) DeleteAccessServiceToken(accountID, tokenID string) error {
+	uri := "/accounts/" + accountID + "/access/service_tokens/" + tokenID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAccessServiceToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "CI/CD token"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+            "name": "CI/CD token",
+            "client_id": "88bf3b6d86161464f6509f7219099e57.access.example.com",
+            "client_secret": "bdd31cbc4dec990953e39163fbbb194c93313ca9f0a6e420346af9d326b1d2a5",
+            "created_at": "2014-01-01T05:20:00Z",
+            "updated_at": "2014-01-01T05:20:00Z",
+            "expires_at": "2015-01-01T05:20:00Z",
+            "duration": "8760h"
+          }
+        }`)
+	})
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00Z")
+	expiresAt, _ := time.Parse(time.RFC3339, "2015-01-01T05:20:00Z")
+	want := AccessServiceToken{
+		ID:           "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+		Name:         "CI/CD token",
+		ClientID:     "88bf3b6d86161464f6509f7219099e57.access.example.com",
+		ClientSecret: "bdd31cbc4dec990953e39163fbbb194c93313ca9f0a6e420346af9d326b1d2a5",
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &createdAt,
+		ExpiresAt:    &expiresAt,
+		Duration:     "8760h",
+	}
+
+	actual, err := client.CreateAccessServiceToken(testAccountID, "CI/CD token")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestAccessServiceTokens(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+              "name": "CI/CD token",
+              "client_id": "88bf3b6d86161464f6509f7219099e57.access.example.com",
+              "duration": "8760h"
+            }
+          ],
+          "result_info": {"page": 1, "per_page": 20, "count": 1, "total_count": 1}
+        }`)
+	})
+
+	tokens, err := client.AccessServiceTokens(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(tokens)) {
+			assert.Equal(t, "88bf3b6d86161464f6509f7219099e57.access.example.com", tokens[0].ClientID)
+			assert.Empty(t, tokens[0].ClientSecret)
+		}
+	}
+}
+
+func TestAccessServiceTokens_Paginated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [{"id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", "name": "CI/CD token"}],
+          "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 2, "total_pages": 2}
+        }`)
+		case "2":
+			fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [{"id": "3a0ae6ee-7b7a-4b0e-a0c5-9e5fba6a3bd1", "name": "Monitoring token"}],
+          "result_info": {"page": 2, "per_page": 50, "count": 1, "total_count": 2, "total_pages": 2}
+        }`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	tokens, err := client.AccessServiceTokens(testAccountID)
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(tokens)) {
+		assert.Equal(t, "CI/CD token", tokens[0].Name)
+		assert.Equal(t, "Monitoring token", tokens[1].Name)
+	}
+}
+
+func TestRefreshAccessServiceToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens/f174e90a-fafe-4643-bbbc-4a0ed4fc8415/refresh", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", "name": "CI/CD token", "expires_at": "2016-01-01T05:20:00Z"}
+        }`)
+	})
+
+	expiresAt, _ := time.Parse(time.RFC3339, "2016-01-01T05:20:00Z")
+	token, err := client.RefreshAccessServiceToken(testAccountID, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &expiresAt, token.ExpiresAt)
+		assert.Empty(t, token.ClientSecret)
+	}
+}
+
+func TestRotateAccessServiceToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens/f174e90a-fafe-4643-bbbc-4a0ed4fc8415/rotate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+            "name": "CI/CD token",
+            "client_id": "88bf3b6d86161464f6509f7219099e57.access.example.com",
+            "client_secret": "a9b2c8e5f1d4a7b0c3e6f9a2d5b8c1e4f7a0b3d6c9e2f5a8b1d4c7e0f3a6b9c2"
+          }
+        }`)
+	})
+
+	token, err := client.RotateAccessServiceToken(testAccountID, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "a9b2c8e5f1d4a7b0c3e6f9a2d5b8c1e4f7a0b3d6c9e2f5a8b1d4c7e0f3a6b9c2", token.ClientSecret)
+	}
+}
+
+func TestDeleteAccessServiceToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/access/service_tokens/f174e90a-fafe-4643-bbbc-4a0ed4fc8415", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415"}}`)
+	})
+
+	assert.NoError(t, client.DeleteAccessServiceToken(testAccountID, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415"))
+}
@@ -0,0 +1,226 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// APIToken describes a scoped API token. Value, the secret, is only set on
+// the token returned by CreateAPIToken; it cannot be retrieved later, only
+// replaced through RollAPIToken.
+type APIToken struct {
+	ID         string             `json:"id,omitempty"`
+	Name       string             `json:"name"`
+	Status     string             `json:"status,omitempty"`
+	Policies   []APITokenPolicy   `json:"policies"`
+	Condition  *APITokenCondition `json:"condition,omitempty"`
+	NotBefore  *time.Time         `json:"not_before,omitempty"`
+	ExpiresOn  *time.Time         `json:"expires_on,omitempty"`
+	IssuedOn   *time.Time         `json:"issued_on,omitempty"`
+	ModifiedOn *time.Time         `json:"modified_on,omitempty"`
+	Value      string             `json:"value,omitempty"`
+}
+
+// APITokenPolicy grants, or with an Effect of "deny" withholds, the
+// permission groups on the resources of a token. Resources maps resource
+// names, e.g. "com.cloudflare.api.account.zone.<zone ID>", to "*".
+type APITokenPolicy struct {
+	ID               string                    `json:"id,omitempty"`
+	Effect           string                    `json:"effect"`
+	Resources        map[string]interface{}    `json:"resources"`
+	PermissionGroups []APITokenPermissionGroup `json:"permission_groups"`
+}
+
+// APITokenPermissionGroup is a named set of permissions, such as
+// "SSL and Certificates Write", which a policy grants. Only ID is needed
+// when building a policy.
+type APITokenPermissionGroup struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// APITokenCondition restricts where a token can be used from.
+type APITokenCondition struct {
+	RequestIP *APITokenRequestIPCondition `json:"request.ip,omitempty"`
+}
+
+// APITokenRequestIPCondition lists the CIDRs requests using a token must, or
+// must not, come from.
+type APITokenRequestIPCondition struct {
+	In    []string `json:"in,omitempty"`
+	NotIn []string `json:"not_in,omitempty"`
+}
+
+// APITokenResponse represents the response from the API token endpoints
+// containing a single token.
+type APITokenResponse struct {
+	Response
+	Result APIToken `json:"result"`
+}
+
+// APITokenListResponse represents the response from the list API tokens
+// endpoint.
+type APITokenListResponse struct {
+	Response
+	Result     []APIToken `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// APITokenRollResponse represents the response from the roll API token
+// endpoint, carrying the new secret.
+type APITokenRollResponse struct {
+	Response
+	Result string `json:"result"`
+}
+
+// APITokenPermissionGroupsResponse represents the response from the list
+// API token permission groups endpoint.
+type APITokenPermissionGroupsResponse struct {
+	Response
+	Result []APITokenPermissionGroup `json:"result"`
+}
+
+// tokensBaseURL returns the base URL of the API token endpoints: those of the
+// current user or, with UsingOrganization, those of the account.
+func (api *API) tokensBaseURL() string {
+	if api.organizationID != "" {
+		return "/accounts/" + api.organizationID + "/tokens"
+	}
+	return "/user/tokens"
+}
+
+// CreateAPIToken creates a new API token. The returned token carries the
+// secret in Value, which is not returned again by any other call.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-create-token
+func (api *API) CreateAPIToken(token APIToken) (APIToken, error) {
+	if token.Name == "" {
+		return APIToken{}, errors.New("API token name cannot be empty")
+	}
+	res, err := api.makeRequest("POST", api.tokensBaseURL(), token)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r APITokenResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return APIToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// APITokens lists all API tokens. Their secrets are not included.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-list-tokens
+func (api *API) APITokens() ([]APIToken, error) {
+	var tokens []APIToken
+	err := api.forEachPage(api.tokensBaseURL(), PaginationOptions{PerPage: 50}, 50, func(res []byte) (ResultInfo, error) {
+		var r APITokenListResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		tokens = append(tokens, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []APIToken{}, err
+	}
+	return tokens, nil
+}
+
+// GetAPIToken returns a single API token, without its secret.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-token-details
+func (api *API) GetAPIToken(tokenID string) (APIToken, error) {
+	res, err := api.makeRequest("GET", api.tokensBaseURL()+"/"+tokenID, nil)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r APITokenResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return APIToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateAPIToken replaces the name, policies, condition and validity of an
+// existing API token. Its secret is left unchanged and never sent, even if
+// token carries it.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-update-token
+func (api *API) UpdateAPIToken(tokenID string, token APIToken) (APIToken, error) {
+	if tokenID == "" {
+		return APIToken{}, errors.New("API token ID cannot be empty")
+	}
+	token.Value = ""
+	res, err := api.makeRequest("PUT", api.tokensBaseURL()+"/"+tokenID, token)
+	if err != nil {
+		return APIToken{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r APITokenResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return APIToken{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// RollAPIToken replaces the secret of an API token, invalidating the previous
+// one, and returns the new secret.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-roll-token
+func (api *API) RollAPIToken(tokenID string) (string, error) {
+	if tokenID == "" {
+		return "", errors.New("API token ID cannot be empty")
+	}
+	res, err := api.makeRequest("PUT", api.tokensBaseURL()+"/"+tokenID+"/value", struct{}{})
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r APITokenRollResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteAPIToken deletes an API token. Requests using it fail from then on.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-delete-token
+func (api *API) DeleteAPIToken(tokenID string) error {
+	if tokenID == "" {
+		return errors.New("API token ID cannot be empty")
+	}
+	if _, err := api.makeRequest("DELETE", api.tokensBaseURL()+"/"+tokenID, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// ListAPITokenPermissionGroups lists the permission groups which can be
+// granted by API token policies.
+//
+// API reference: https://api.cloudflare.com/#permission-groups-list-permission-groups
+func (api *API) ListAPITokenPermissionGroups() ([]APITokenPermissionGroup, error) {
+	res, err := api.makeRequest("GET", api.tokensBaseURL()+"/permission_groups", nil)
+	if err != nil {
+		return []APITokenPermissionGroup{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r APITokenPermissionGroupsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []APITokenPermissionGroup{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// FindAPITokenPermissionGroup returns the group named name, e.g.
+// "SSL and Certificates Write", from groups as returned by
+// ListAPITokenPermissionGroups. It reports false if there is none.
+func FindAPITokenPermissionGroup(groups []APITokenPermissionGroup, name string) (APITokenPermissionGroup, bool) {
+	for _, g := range groups {
+		if g.Name == name {
+			return g, true
+		}
+	}
+	return APITokenPermissionGroup{}, false
+}
@@ -0,0 +1,202 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const apiTokenJSON = `{
+  "id": "ed17574386854bf78a67040be0a770b0",
+  "name": "custom hostnames",
+  "status": "active",
+  "issued_on": "2021-01-01T05:20:00Z",
+  "modified_on": "2021-01-01T05:20:00Z",
+  "expires_on": "2022-01-01T00:00:00Z",
+  "policies": [
+    {
+      "id": "f267e341f3dd4697bd3b9f71dd96247f",
+      "effect": "allow",
+      "resources": {"com.cloudflare.api.account.zone.023e105f4ecef8ad9ca31a8372d0c353": "*"},
+      "permission_groups": [{"id": "c8fed203ed3043cba015a93ad1616f1f", "name": "SSL and Certificates Write"}]
+    }
+  ],
+  "condition": {"request.ip": {"in": ["192.0.2.0/24"]}}
+}`
+
+func TestCreateAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "custom hostnames",
+              "expires_on": "2022-01-01T00:00:00Z",
+              "policies": [
+                {
+                  "effect": "allow",
+                  "resources": {"com.cloudflare.api.account.zone.023e105f4ecef8ad9ca31a8372d0c353": "*"},
+                  "permission_groups": [{"id": "c8fed203ed3043cba015a93ad1616f1f"}]
+                }
+              ],
+              "condition": {"request.ip": {"in": ["192.0.2.0/24"]}}
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`,
+			apiTokenJSON[:len(apiTokenJSON)-1]+`, "value": "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"}`)
+	})
+
+	expiresOn := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := APIToken{
+		Name:      "custom hostnames",
+		ExpiresOn: &expiresOn,
+		Policies: []APITokenPolicy{{
+			Effect:           "allow",
+			Resources:        map[string]interface{}{"com.cloudflare.api.account.zone.023e105f4ecef8ad9ca31a8372d0c353": "*"},
+			PermissionGroups: []APITokenPermissionGroup{{ID: "c8fed203ed3043cba015a93ad1616f1f"}},
+		}},
+		Condition: &APITokenCondition{RequestIP: &APITokenRequestIPCondition{In: []string{"192.0.2.0/24"}}},
+	}
+
+	actual, err := client.CreateAPIToken(token)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ed17574386854bf78a67040be0a770b0", actual.ID)
+		assert.Equal(t, "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T", actual.Value)
+		assert.Equal(t, "SSL and Certificates Write", actual.Policies[0].PermissionGroups[0].Name)
+		assert.Equal(t, expiresOn, *actual.ExpiresOn)
+	}
+
+	_, err = client.CreateAPIToken(APIToken{})
+	assert.EqualError(t, err, "API token name cannot be empty")
+}
+
+func TestAPITokensOmitSecret(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+          "success": true, "errors": [], "messages": [], "result": [%s],
+          "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+        }`, apiTokenJSON)
+	})
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, apiTokenJSON)
+	})
+
+	tokens, err := client.APITokens()
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(tokens)) {
+		assert.Equal(t, "custom hostnames", tokens[0].Name)
+		assert.Empty(t, tokens[0].Value)
+	}
+
+	token, err := client.GetAPIToken("ed17574386854bf78a67040be0a770b0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "active", token.Status)
+		assert.Empty(t, token.Value)
+	}
+}
+
+func TestUpdateAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.NotContains(t, string(b), "value")
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, apiTokenJSON)
+	})
+
+	actual, err := client.UpdateAPIToken("ed17574386854bf78a67040be0a770b0", APIToken{
+		Name:  "custom hostnames",
+		Value: "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T",
+	})
+	if assert.NoError(t, err) {
+		assert.Empty(t, actual.Value)
+	}
+
+	_, err = client.UpdateAPIToken("", APIToken{})
+	assert.EqualError(t, err, "API token ID cannot be empty")
+}
+
+func TestRollAPIToken(t *testing.T) {
+	setup(UsingOrganization(testAccountID))
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/tokens/ed17574386854bf78a67040be0a770b0/value", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"}`)
+	})
+
+	value, err := client.RollAPIToken("ed17574386854bf78a67040be0a770b0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T", value)
+	}
+}
+
+func TestDeleteAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "ed17574386854bf78a67040be0a770b0"}}`)
+	})
+
+	assert.NoError(t, client.DeleteAPIToken("ed17574386854bf78a67040be0a770b0"))
+}
+
+func TestListAPITokenPermissionGroups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/permission_groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {"id": "c8fed203ed3043cba015a93ad1616f1f", "name": "SSL and Certificates Write", "scopes": ["com.cloudflare.api.account.zone"]},
+            {"id": "7b7216b327b04b8fbc8f524e1f9b7531", "name": "SSL and Certificates Read", "scopes": ["com.cloudflare.api.account.zone"]}
+          ]
+        }`)
+	})
+
+	groups, err := client.ListAPITokenPermissionGroups()
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(groups)) {
+		assert.Equal(t, APITokenPermissionGroup{
+			ID:     "c8fed203ed3043cba015a93ad1616f1f",
+			Name:   "SSL and Certificates Write",
+			Scopes: []string{"com.cloudflare.api.account.zone"},
+		}, groups[0])
+	}
+
+	group, ok := FindAPITokenPermissionGroup(groups, "SSL and Certificates Read")
+	assert.True(t, ok)
+	assert.Equal(t, "7b7216b327b04b8fbc8f524e1f9b7531", group.ID)
+
+	_, ok = FindAPITokenPermissionGroup(groups, "DNS Write")
+	assert.False(t, ok)
+}
@@ -0,0 +1,137 @@
+package cloudflare
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuditLogAction describes what was done and whether it succeeded.
+type AuditLogAction struct {
+	Result bool   `json:"result"`
+	Type   string `json:"type"`
+}
+
+// AuditLogActor identifies who performed an action, e.g. a user or an API
+// token.
+type AuditLogActor struct {
+	Email string `json:"email"`
+	ID    string `json:"id"`
+	IP    string `json:"ip"`
+	Type  string `json:"type"`
+}
+
+// AuditLogOwner identifies the owner of the changed resource.
+type AuditLogOwner struct {
+	ID string `json:"id"`
+}
+
+// AuditLogResource identifies the resource acted upon, e.g. a zone setting
+// or a custom hostname.
+type AuditLogResource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// AuditLog is a single entry of an account's audit log. OldValue and
+// NewValue hold the resource before and after the change when the API
+// records them.
+type AuditLog struct {
+	ID        string                 `json:"id"`
+	Action    AuditLogAction         `json:"action"`
+	Actor     AuditLogActor          `json:"actor"`
+	Interface string                 `json:"interface"`
+	Metadata  map[string]interface{} `json:"metadata"`
+	NewValue  string                 `json:"newValue"`
+	OldValue  string                 `json:"oldValue"`
+	Owner     AuditLogOwner          `json:"owner"`
+	Resource  AuditLogResource       `json:"resource"`
+	When      time.Time              `json:"when"`
+}
+
+// AuditLogResponse represents the response from the audit logs endpoint.
+type AuditLogResponse struct {
+	Response
+	Result     []AuditLog `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// AuditLogFilter selects the entries returned by AuditLogs. Every field is
+// optional and left out of the query when empty.
+type AuditLogFilter struct {
+	ID         string
+	ActorIP    string
+	ActorEmail string
+	// ActionType is e.g. "add", "change" or "delete".
+	ActionType string
+	ZoneName   string
+	Since      time.Time
+	Before     time.Time
+	// Direction is the sort direction by time: "asc" or "desc".
+	Direction string
+	Page      int
+	PerPage   int
+}
+
+// encode encodes the non-empty filter fields into URL query values.
+func (f AuditLogFilter) encode() url.Values {
+	v := url.Values{}
+	if f.ID != "" {
+		v.Set("id", f.ID)
+	}
+	if f.ActorIP != "" {
+		v.Set("actor.ip", f.ActorIP)
+	}
+	if f.ActorEmail != "" {
+		v.Set("actor.email", f.ActorEmail)
+	}
+	if f.ActionType != "" {
+		v.Set("action.type", f.ActionType)
+	}
+	if f.ZoneName != "" {
+		v.Set("zone.name", f.ZoneName)
+	}
+	if !f.Since.IsZero() {
+		v.Set("since", f.Since.UTC().Format(time.RFC3339))
+	}
+	if !f.Before.IsZero() {
+		v.Set("before", f.Before.UTC().Format(time.RFC3339))
+	}
+	if f.Direction != "" {
+		v.Set("direction", f.Direction)
+	}
+	if f.Page > 0 {
+		v.Set("page", strconv.Itoa(f.Page))
+	}
+	if f.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(f.PerPage))
+	}
+	return v
+}
+
+// AuditLogs returns the audit log entries of the given account matching
+// filter, such as changes to zone settings or custom hostnames.
+//
+// The returned ResultInfo can be used to implement pagination.
+//
+// API reference: https://api.cloudflare.com/#audit-logs-get-account-audit-logs
+func (api *API) AuditLogs(accountID string, filter AuditLogFilter) ([]AuditLog, ResultInfo, error) {
+	if !filter.Since.IsZero() && !filter.Before.IsZero() && !filter.Since.Before(filter.Before) {
+		return []AuditLog{}, ResultInfo{}, errors.New("audit log filter start time must be before its end time")
+	}
+	uri := "/accounts/" + accountID + "/audit_logs"
+	if v := filter.encode(); len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []AuditLog{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r AuditLogResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []AuditLog{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
@@ -0,0 +1,85 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogFilterEncode(t *testing.T) {
+	filter := AuditLogFilter{
+		ActorEmail: "user@example.com",
+		ActionType: "change",
+		ZoneName:   "example.com",
+		Since:      time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+		Before:     time.Date(2022, 3, 2, 0, 0, 0, 0, time.FixedZone("CET", 3600)),
+		PerPage:    25,
+	}
+	assert.Equal(t, "action.type=change&actor.email=user%40example.com&before=2022-03-01T23%3A00%3A00Z&per_page=25&since=2022-03-01T00%3A00%3A00Z&zone.name=example.com", filter.encode().Encode())
+	assert.Equal(t, "", AuditLogFilter{}.encode().Encode())
+}
+
+func TestAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/audit_logs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "198.51.100.4", r.URL.Query().Get("actor.ip"))
+		assert.Equal(t, "2022-03-01T00:00:00Z", r.URL.Query().Get("since"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "d5b0f326-1232-4452-8858-1089bd7168ef",
+              "action": {"result": true, "type": "change"},
+              "actor": {"email": "user@example.com", "id": "f6b5de0326bb5182b8a4840ee01ec774", "ip": "198.51.100.4", "type": "user"},
+              "interface": "API",
+              "metadata": {"zone_name": "example.com"},
+              "newValue": "on",
+              "oldValue": "off",
+              "owner": {"id": "023e105f4ecef8ad9ca31a8372d0c353"},
+              "resource": {"id": "always_online", "type": "zone_setting"},
+              "when": "2022-03-01T12:30:00Z"
+            }
+          ],
+          "result_info": {"page": 1, "per_page": 100, "count": 1, "total_count": 1, "total_pages": 1}
+        }`)
+	})
+
+	logs, resultInfo, err := client.AuditLogs(testAccountID, AuditLogFilter{
+		ActorIP: "198.51.100.4",
+		Since:   time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if assert.NoError(t, err) {
+		want := []AuditLog{{
+			ID:        "d5b0f326-1232-4452-8858-1089bd7168ef",
+			Action:    AuditLogAction{Result: true, Type: "change"},
+			Actor:     AuditLogActor{Email: "user@example.com", ID: "f6b5de0326bb5182b8a4840ee01ec774", IP: "198.51.100.4", Type: "user"},
+			Interface: "API",
+			Metadata:  map[string]interface{}{"zone_name": "example.com"},
+			NewValue:  "on",
+			OldValue:  "off",
+			Owner:     AuditLogOwner{ID: "023e105f4ecef8ad9ca31a8372d0c353"},
+			Resource:  AuditLogResource{ID: "always_online", Type: "zone_setting"},
+			When:      time.Date(2022, 3, 1, 12, 30, 0, 0, time.UTC),
+		}}
+		assert.Equal(t, want, logs)
+		assert.Equal(t, 1, resultInfo.TotalPages)
+	}
+}
+
+func TestAuditLogsInvalidTimeRange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)
+	_, _, err := client.AuditLogs(testAccountID, AuditLogFilter{Since: since, Before: since.Add(-time.Hour)})
+	assert.EqualError(t, err, "audit log filter start time must be before its end time")
+}
@@ -0,0 +1,133 @@
+package cloudflare
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PerZoneAuthenticatedOriginPullsCertificateParams holds the client
+// certificate and private key presented to the origin for a zone.
+type PerZoneAuthenticatedOriginPullsCertificateParams struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// String implements fmt.Stringer, redacting the private key so the params
+// can be logged safely.
+func (p PerZoneAuthenticatedOriginPullsCertificateParams) String() string {
+	return fmt.Sprintf("{Certificate:%s PrivateKey:[REDACTED]}", p.Certificate)
+}
+
+// PerZoneAuthenticatedOriginPullsCertificateDetails represents a client
+// certificate uploaded for a zone. The private key is never returned.
+type PerZoneAuthenticatedOriginPullsCertificateDetails struct {
+	ID          string    `json:"id"`
+	Certificate string    `json:"certificate"`
+	Issuer      string    `json:"issuer"`
+	Signature   string    `json:"signature"`
+	Status      string    `json:"status"`
+	ExpiresOn   time.Time `json:"expires_on"`
+	UploadedOn  time.Time `json:"uploaded_on"`
+}
+
+// PerHostnameAuthenticatedOriginPullsConfig describes whether Authenticated
+// Origin Pulls are enabled for a single hostname.
+type PerHostnameAuthenticatedOriginPullsConfig struct {
+	Hostname string `json:"hostname"`
+	CertID   string `json:"cert_id,omitempty"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// PerHostnameAuthenticatedOriginPullsDetails represents the Authenticated
+// Origin Pulls configuration of a hostname.
+type PerHostnameAuthenticatedOriginPullsDetails struct {
+	Hostname       string    `json:"hostname"`
+	CertID         string    `json:"cert_id"`
+	Enabled        bool      `json:"enabled"`
+	Status         string    `json:"status"`
+	CertStatus     string    `json:"cert_status"`
+	Issuer         string    `json:"issuer"`
+	Signature      string    `json:"signature"`
+	ExpiresOn      time.Time `json:"expires_on"`
+	CertUploadedOn time.Time `json:"cert_uploaded_on"`
+}
+
+// perZoneAuthenticatedOriginPullsCertificateResponse represents the response
+// from the per-zone certificate upload endpoint.
+type perZoneAuthenticatedOriginPullsCertificateResponse struct {
+	Response
+	Result PerZoneAuthenticatedOriginPullsCertificateDetails `json:"result"`
+}
+
+// authenticatedOriginPullsSettingsResponse represents the response from the
+// per-zone Authenticated Origin Pulls settings endpoint.
+type authenticatedOriginPullsSettingsResponse struct {
+	Response
+	Result struct {
+		Enabled bool `json:"enabled"`
+	} `json:"result"`
+}
+
+// perHostnameAuthenticatedOriginPullsResponse represents the response from
+// the per-hostname Authenticated Origin Pulls endpoint.
+type perHostnameAuthenticatedOriginPullsResponse struct {
+	Response
+	Result []PerHostnameAuthenticatedOriginPullsDetails `json:"result"`
+}
+
+// UploadPerZoneAuthenticatedOriginPullsCertificate uploads the client
+// certificate Cloudflare presents to the origin for the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-upload-certificate
+func (api *API) UploadPerZoneAuthenticatedOriginPullsCertificate(zoneID string, params PerZoneAuthenticatedOriginPullsCertificateParams) (PerZoneAuthenticatedOriginPullsCertificateDetails, error) {
+	uri := "/zones/" + zoneID + "/origin_tls_client_auth"
+	res, err := api.makeRequest("POST", uri, params)
+	if err != nil {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r perZoneAuthenticatedOriginPullsCertificateResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return PerZoneAuthenticatedOriginPullsCertificateDetails{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SetPerZoneAuthenticatedOriginPullsStatus enables or disables Authenticated
+// Origin Pulls for the given zone and returns whether it is now enabled.
+//
+// API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-set-enablement-for-zone
+func (api *API) SetPerZoneAuthenticatedOriginPullsStatus(zoneID string, enabled bool) (bool, error) {
+	uri := "/zones/" + zoneID + "/origin_tls_client_auth/settings"
+	res, err := api.makeRequest("PUT", uri, struct {
+		Enabled bool `json:"enabled"`
+	}{enabled})
+	if err != nil {
+		return false, errors.Wrap(err, errMakeRequestError)
+	}
+	var r authenticatedOriginPullsSettingsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return false, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Enabled, nil
+}
+
+// UpdatePerHostnameAuthenticatedOriginPullsStatus enables or disables
+// Authenticated Origin Pulls for a single hostname of the given zone.
+//
+// API reference: https://api.cloudflare.com/#per-hostname-authenticated-origin-pull-enable-or-disable-a-hostname-for-client-authentication
+func (api *API) UpdatePerHostnameAuthenticatedOriginPullsStatus(zoneID, hostname string, enabled bool) ([]PerHostnameAuthenticatedOriginPullsDetails, error) {
+	uri := "/zones/" + zoneID + "/origin_tls_client_auth/hostnames"
+	res, err := api.makeRequest("PUT", uri, struct {
+		Config []PerHostnameAuthenticatedOriginPullsConfig `json:"config"`
+	}{[]PerHostnameAuthenticatedOriginPullsConfig{{Hostname: hostname, Enabled: enabled}}})
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	var r perHostnameAuthenticatedOriginPullsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,72 @@
+package cloudflare
+
+import (
+	"github.com/pkg/errors"
+)
+
+// BotManagement describes the Bot Management configuration of a zone.
+//
+// The sbfm (Super Bot Fight Mode) fields hold the action taken on a class of
+// traffic: "allow", "block" or "managed_challenge".
+type BotManagement struct {
+	EnableJS                bool   `json:"enable_js"`
+	FightMode               bool   `json:"fight_mode"`
+	SBFMDefinitelyAutomated string `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated     string `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots        string `json:"sbfm_verified_bots,omitempty"`
+	SuppressSessionScore    bool   `json:"suppress_session_score"`
+	AutoUpdateModel         bool   `json:"auto_update_model"`
+	UsingLatestModel        bool   `json:"using_latest_model"`
+}
+
+// BotManagementUpdate lists the Bot Management settings to change. Fields
+// left nil keep their current value.
+type BotManagementUpdate struct {
+	EnableJS                *bool   `json:"enable_js,omitempty"`
+	FightMode               *bool   `json:"fight_mode,omitempty"`
+	SBFMDefinitelyAutomated *string `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated     *string `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots        *string `json:"sbfm_verified_bots,omitempty"`
+	SuppressSessionScore    *bool   `json:"suppress_session_score,omitempty"`
+	AutoUpdateModel         *bool   `json:"auto_update_model,omitempty"`
+}
+
+// BotManagementResponse represents the response from the Bot Management
+// endpoints.
+type BotManagementResponse struct {
+	Response
+	Result BotManagement `json:"result"`
+}
+
+// BotManagement returns the Bot Management configuration of the given zone.
+//
+// API reference: https://api.cloudflare.com/#bot-management-for-a-zone-get-config
+func (api *API) BotManagement(zoneID string) (BotManagement, error) {
+	uri := "/zones/" + zoneID + "/bot_management"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return BotManagement{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r BotManagementResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return BotManagement{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateBotManagement changes the Bot Management configuration of the given
+// zone and returns the resulting configuration.
+//
+// API reference: https://api.cloudflare.com/#bot-management-for-a-zone-update-config
+func (api *API) UpdateBotManagement(zoneID string, update BotManagementUpdate) (BotManagement, error) {
+	uri := "/zones/" + zoneID + "/bot_management"
+	res, err := api.makeRequest("PUT", uri, update)
+	if err != nil {
+		return BotManagement{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r BotManagementResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return BotManagement{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,80 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBotManagement(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/bot_management", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "enable_js": true,
+            "fight_mode": false,
+            "sbfm_definitely_automated": "block",
+            "sbfm_likely_automated": "managed_challenge",
+            "sbfm_verified_bots": "allow",
+            "suppress_session_score": false,
+            "auto_update_model": true,
+            "using_latest_model": true
+          }
+        }`)
+	})
+
+	want := BotManagement{
+		EnableJS:                true,
+		SBFMDefinitelyAutomated: "block",
+		SBFMLikelyAutomated:     "managed_challenge",
+		SBFMVerifiedBots:        "allow",
+		AutoUpdateModel:         true,
+		UsingLatestModel:        true,
+	}
+
+	actual, err := client.BotManagement("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateBotManagement_FightMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/bot_management", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"fight_mode": true}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "enable_js": true,
+            "fight_mode": true
+          }
+        }`)
+	})
+
+	fightMode := true
+	actual, err := client.UpdateBotManagement("023e105f4ecef8ad9ca31a8372d0c353", BotManagementUpdate{FightMode: &fightMode})
+	if assert.NoError(t, err) {
+		assert.True(t, actual.FightMode)
+		assert.True(t, actual.EnableJS)
+	}
+}
@@ -0,0 +1,81 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneCacheSetting describes a zone level cache setting, such as Cache
+// Reserve or Tiered Cache Smart Topology. Value is either "on" or "off".
+type ZoneCacheSetting struct {
+	ID         string     `json:"id,omitempty"`
+	Value      string     `json:"value"`
+	Editable   bool       `json:"editable,omitempty"`
+	ModifiedOn *time.Time `json:"modified_on,omitempty"`
+}
+
+// ZoneCacheSettingResponse represents the response from the zone cache
+// settings endpoints.
+type ZoneCacheSettingResponse struct {
+	Response
+	Result ZoneCacheSetting `json:"result"`
+}
+
+// CacheReserve returns the Cache Reserve setting of the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-get-cache-reserve-setting
+func (api *API) CacheReserve(zoneID string) (ZoneCacheSetting, error) {
+	return api.zoneCacheSetting(zoneID, "cache_reserve")
+}
+
+// UpdateCacheReserve enables or disables Cache Reserve for the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-change-cache-reserve-setting
+func (api *API) UpdateCacheReserve(zoneID string, enabled bool) (ZoneCacheSetting, error) {
+	return api.updateZoneCacheSetting(zoneID, "cache_reserve", enabled)
+}
+
+// TieredCacheSmartTopology returns the Tiered Cache Smart Topology setting of
+// the given zone.
+//
+// API reference: https://api.cloudflare.com/#smart-tiered-cache-get-smart-tiered-cache-setting
+func (api *API) TieredCacheSmartTopology(zoneID string) (ZoneCacheSetting, error) {
+	return api.zoneCacheSetting(zoneID, "tiered_cache_smart_topology_enable")
+}
+
+// UpdateTieredCacheSmartTopology enables or disables Tiered Cache Smart
+// Topology for the given zone.
+//
+// API reference: https://api.cloudflare.com/#smart-tiered-cache-patch-smart-tiered-cache-setting
+func (api *API) UpdateTieredCacheSmartTopology(zoneID string, enabled bool) (ZoneCacheSetting, error) {
+	return api.updateZoneCacheSetting(zoneID, "tiered_cache_smart_topology_enable", enabled)
+}
+
+// zoneCacheSetting fetches the named cache setting of a zone.
+func (api *API) zoneCacheSetting(zoneID, setting string) (ZoneCacheSetting, error) {
+	uri := "/zones/" + zoneID + "/cache/" + setting
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ZoneCacheSetting{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneCacheSettingResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ZoneCacheSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// updateZoneCacheSetting toggles the named cache setting of a zone.
+func (api *API) updateZoneCacheSetting(zoneID, setting string, enabled bool) (ZoneCacheSetting, error) {
+	uri := "/zones/" + zoneID + "/cache/" + setting
+	res, err := api.makeRequest("PATCH", uri, ZoneCacheSetting{Value: onOff(enabled)})
+	if err != nil {
+		return ZoneCacheSetting{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneCacheSettingResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ZoneCacheSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheReserve(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/01a7362d577a6c3019a474fd6f485823/cache/cache_reserve", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "cache_reserve",
+            "value": "on",
+            "editable": true,
+            "modified_on": "2022-11-02T10:15:00Z"
+          }
+        }`)
+	})
+
+	modifiedOn, _ := time.Parse(time.RFC3339, "2022-11-02T10:15:00Z")
+	want := ZoneCacheSetting{
+		ID:         "cache_reserve",
+		Value:      "on",
+		Editable:   true,
+		ModifiedOn: &modifiedOn,
+	}
+
+	actual, err := client.CacheReserve("01a7362d577a6c3019a474fd6f485823")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateZoneCacheSettings(t *testing.T) {
+	for _, tc := range []struct {
+		setting string
+		enabled bool
+		value   string
+		update  func(zoneID string, enabled bool) (ZoneCacheSetting, error)
+	}{
+		{"cache_reserve", true, "on", func(z string, e bool) (ZoneCacheSetting, error) { return client.UpdateCacheReserve(z, e) }},
+		{"cache_reserve", false, "off", func(z string, e bool) (ZoneCacheSetting, error) { return client.UpdateCacheReserve(z, e) }},
+		{"tiered_cache_smart_topology_enable", true, "on", func(z string, e bool) (ZoneCacheSetting, error) { return client.UpdateTieredCacheSmartTopology(z, e) }},
+		{"tiered_cache_smart_topology_enable", false, "off", func(z string, e bool) (ZoneCacheSetting, error) { return client.UpdateTieredCacheSmartTopology(z, e) }},
+	} {
+		setup()
+
+		mux.HandleFunc("/zones/01a7362d577a6c3019a474fd6f485823/cache/"+tc.setting, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+			b, err := ioutil.ReadAll(r.Body)
+			defer r.Body.Close()
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, fmt.Sprintf(`{"value":"%s"}`, tc.value), string(b))
+			}
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprintf(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": {
+                "id": "%s",
+                "value": "%s",
+                "editable": true
+              }
+            }`, tc.setting, tc.value)
+		})
+
+		actual, err := tc.update("01a7362d577a6c3019a474fd6f485823", tc.enabled)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.setting, actual.ID)
+			assert.Equal(t, tc.value, actual.Value)
+		}
+
+		teardown()
+	}
+}
+
+func TestTieredCacheSmartTopology(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/01a7362d577a6c3019a474fd6f485823/cache/tiered_cache_smart_topology_enable", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "tiered_cache_smart_topology_enable",
+            "value": "off",
+            "editable": true
+          }
+        }`)
+	})
+
+	actual, err := client.TieredCacheSmartTopology("01a7362d577a6c3019a474fd6f485823")
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneCacheSetting{ID: "tiered_cache_smart_topology_enable", Value: "off", Editable: true}, actual)
+	}
+}
@@ -5,12 +5,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,6 +35,7 @@ type API struct {
 	APIKey            string
 	APIEmail          string
 	APIUserServiceKey string
+	APIToken          string
 	BaseURL           string
 	organizationID    string
 	headers           http.Header
@@ -39,29 +44,64 @@ type API struct {
 	rateLimiter       *rate.Limiter
 	retryPolicy       RetryPolicy
 	logger            Logger
+	strictJSON        bool
+	metadataLimit     int
+	etags             *etagCache
+	maxResponseBytes  int64
+	signer            RequestSigner
+	credentials       CredentialsProvider
+	lastMeta          *responseMetaStore
+	timeout           time.Duration
+
+	// OnRetry, if set, is called before each retry of a request with the
+	// number of the upcoming attempt (starting at 1), the error which caused
+	// the retry and the backoff about to be waited.
+	OnRetry func(attempt int, err error, wait time.Duration)
 }
 
-// New creates a new Cloudflare v4 API client.
+// New creates a new Cloudflare v4 API client authenticating with an API key
+// and email address.
 func New(key, email string, opts ...Option) (*API, error) {
-	if key == "" || email == "" {
-		return nil, errors.New(errEmptyCredentials)
+	return NewWithCredentials(Credentials{APIKey: key, APIEmail: email}, opts...)
+}
+
+// NewWithAPIToken creates a new Cloudflare v4 API client authenticating with
+// a scoped API token.
+func NewWithAPIToken(token string, opts ...Option) (*API, error) {
+	return NewWithCredentials(Credentials{APIToken: token}, opts...)
+}
+
+// NewWithCredentials creates a new Cloudflare v4 API client from creds. An
+// API token cannot be combined with any other credential, an API key and
+// email must be given together, and a user service key may be given on its
+// own or alongside a key and email.
+func NewWithCredentials(creds Credentials, opts ...Option) (*API, error) {
+	if err := creds.validate(); err != nil {
+		return nil, err
 	}
 
 	silentLogger := log.New(ioutil.Discard, "", log.LstdFlags)
 
 	api := &API{
-		APIKey:      key,
-		APIEmail:    email,
-		BaseURL:     apiURL,
-		headers:     make(http.Header),
-		authType:    AuthKeyEmail,
-		rateLimiter: rate.NewLimiter(rate.Limit(4), 1), // 4rps equates to default api limit (1200 req/5 min)
+		APIKey:            creds.APIKey,
+		APIEmail:          creds.APIEmail,
+		APIUserServiceKey: creds.APIUserServiceKey,
+		APIToken:          creds.APIToken,
+		BaseURL:           apiURL,
+		headers:           make(http.Header),
+		authType:          AuthKeyEmail,
+		rateLimiter:       rate.NewLimiter(rate.Limit(4), 1), // 4rps equates to default api limit (1200 req/5 min)
 		retryPolicy: RetryPolicy{
 			MaxRetries:    3,
 			MinRetryDelay: time.Duration(1) * time.Second,
 			MaxRetryDelay: time.Duration(30) * time.Second,
 		},
-		logger: silentLogger,
+		logger:        silentLogger,
+		metadataLimit: defaultCustomMetadataLimit,
+		lastMeta:      &responseMetaStore{},
+	}
+	if creds.APIUserServiceKey != "" && creds.APIKey == "" && creds.APIToken == "" {
+		api.authType = AuthUserService
 	}
 
 	err := api.parseOptions(opts...)
@@ -97,20 +137,121 @@ func (api *API) ZoneIDByName(zoneName string) (string, error) {
 	return "", errors.New("Zone could not be found")
 }
 
+// ZoneIDByHostname returns the ID of the zone which the given hostname belongs
+// to. If several zones match, e.g. both example.com and sub.example.com for
+// www.sub.example.com, the most specific zone is returned.
+func (api *API) ZoneIDByHostname(hostname string) (string, error) {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	labels := strings.Split(hostname, ".")
+	// Every parent domain of at least two labels could be a zone.
+	var candidates []string
+	for i := 0; i < len(labels)-1; i++ {
+		candidates = append(candidates, strings.Join(labels[i:], "."))
+	}
+	if len(candidates) == 0 {
+		return "", errors.New("Zone could not be found")
+	}
+	res, err := api.ListZones(candidates...)
+	if err != nil {
+		return "", errors.Wrap(err, "ListZones command failed")
+	}
+	var match Zone
+	for _, zone := range res {
+		name := strings.ToLower(zone.Name)
+		if (hostname == name || strings.HasSuffix(hostname, "."+name)) && len(name) > len(match.Name) {
+			match = zone
+		}
+	}
+	if match.ID == "" {
+		return "", errors.New("Zone could not be found")
+	}
+	return match.ID, nil
+}
+
 // makeRequest makes a HTTP request and returns the body as a byte slice,
 // closing it before returnng. params will be serialized to JSON.
 func (api *API) makeRequest(method, uri string, params interface{}) ([]byte, error) {
-	return api.makeRequestWithAuthType(method, uri, params, api.authType)
+	return api.makeRequestWithAuthTypeAndHeaders(context.TODO(), method, uri, params, api.authType, nil)
+}
+
+// makeRequestContext is like makeRequest, but the request and any retries are
+// aborted once ctx is done.
+func (api *API) makeRequestContext(ctx context.Context, method, uri string, params interface{}) ([]byte, error) {
+	return api.makeRequestWithAuthTypeAndHeaders(ctx, method, uri, params, api.authType, nil)
+}
+
+// makeRequestWithHeaders is like makeRequest, but merges the given headers
+// into those sent with this request only.
+func (api *API) makeRequestWithHeaders(method, uri string, params interface{}, headers http.Header) ([]byte, error) {
+	return api.makeRequestWithAuthTypeAndHeaders(context.TODO(), method, uri, params, api.authType, headers)
+}
+
+func (api *API) makeRequestWithAuthType(ctx context.Context, method, uri string, params interface{}, authType int) ([]byte, error) {
+	return api.makeRequestWithAuthTypeAndHeaders(ctx, method, uri, params, authType, nil)
 }
 
-func (api *API) makeRequestWithAuthType(method, uri string, params interface{}, authType int) ([]byte, error) {
-	// Replace nil with a JSON object if needed
+// makeRequestWithAuthTypeAndHeaders makes a HTTP request using the given
+// authentication method, merging headers over the client's default headers.
+//
+// The authentication headers for authType are always set last, so headers
+// can only provide credentials that authType itself does not set.
+func (api *API) makeRequestWithAuthTypeAndHeaders(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header) ([]byte, error) {
+	body, _, err := api.doRequest(ctx, method, uri, params, authType, headers)
+	return body, err
+}
+
+// makeConditionalRequest GETs uri like makeRequestContext. When the ETag cache
+// is enabled, the ETag of the last response for uri is sent as If-None-Match
+// and, if the API replies 304 Not Modified, the cached body is returned
+// together with ErrNotModified. A 304 without a cached body is returned as a
+// *RequestError instead.
+func (api *API) makeConditionalRequest(ctx context.Context, uri string) ([]byte, error) {
+	if api.etags == nil {
+		return api.makeRequestContext(ctx, "GET", uri, nil)
+	}
+
+	headers := make(http.Header)
+	cached, ok := api.etags.get(uri)
+	if ok {
+		headers.Set("If-None-Match", cached.etag)
+	}
+	body, respHeaders, err := api.doRequest(ctx, "GET", uri, nil, api.authType, headers)
+	if err == ErrNotModified {
+		// A 304 is only expected in reply to If-None-Match; without a cached
+		// body there is nothing to return, so it is an error of its own.
+		if !ok {
+			return nil, errors.WithStack(&RequestError{StatusCode: http.StatusNotModified, Message: "no cached response for " + uri})
+		}
+		return cached.body, ErrNotModified
+	}
+	if err != nil {
+		return nil, err
+	}
+	if etag := respHeaders.Get("ETag"); etag != "" {
+		api.etags.set(uri, etagEntry{etag: etag, body: body})
+	}
+	return body, nil
+}
+
+// doRequest performs the request for makeRequestWithAuthTypeAndHeaders and
+// additionally returns the headers of the final response.
+func (api *API) doRequest(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header) ([]byte, http.Header, error) {
+	if _, ok := ctx.Deadline(); !ok && api.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, api.timeout)
+		defer cancel()
+	}
+
+	// Replace nil with a JSON object if needed. A []byte is sent as-is, e.g.
+	// for multipart bodies encoded by the caller.
 	var jsonBody []byte
 	var err error
-	if params != nil {
+	if b, ok := params.([]byte); ok {
+		jsonBody = b
+	} else if params != nil {
 		jsonBody, err = json.Marshal(params)
 		if err != nil {
-			return nil, errors.Wrap(err, "error marshalling params to JSON")
+			return nil, nil, errors.Wrap(err, "error marshalling params to JSON")
 		}
 	} else {
 		jsonBody = nil
@@ -120,6 +261,8 @@ func (api *API) makeRequestWithAuthType(method, uri string, params interface{},
 	var respErr error
 	var reqBody io.Reader
 	var respBody []byte
+	var retryErr error
+	start := time.Now()
 	for i := 0; i <= api.retryPolicy.MaxRetries; i++ {
 		if jsonBody != nil {
 			reqBody = bytes.NewReader(jsonBody)
@@ -127,22 +270,31 @@ func (api *API) makeRequestWithAuthType(method, uri string, params interface{},
 
 		if i > 0 {
 			// expect the backoff introduced here on errored requests to dominate the effect of rate limiting
-			// dont need a random component here as the rate limiter should do something similar
-			// nb time duration could truncate an arbitrary float. Since our inputs are all ints, we should be ok
-			sleepDuration := time.Duration(math.Pow(2, float64(i-1)) * float64(api.retryPolicy.MinRetryDelay))
-
-			if sleepDuration > api.retryPolicy.MaxRetryDelay {
-				sleepDuration = api.retryPolicy.MaxRetryDelay
+			sleepDuration := api.retryPolicy.backoff(i)
+			if api.retryPolicy.MaxElapsedTime > 0 && time.Since(start)+sleepDuration > api.retryPolicy.MaxElapsedTime {
+				api.logger.Printf("Giving up on request %s %s after %s", method, uri, time.Since(start).String())
+				break
 			}
 			// useful to do some simple logging here, maybe introduce levels later
 			api.logger.Printf("Sleeping %s before retry attempt number %d for request %s %s", sleepDuration.String(), i, method, uri)
-			time.Sleep(sleepDuration)
+			if api.OnRetry != nil {
+				api.OnRetry(i, retryErr, sleepDuration)
+			}
+			select {
+			case <-time.After(sleepDuration):
+			case <-ctx.Done():
+				return nil, nil, errors.Wrap(ctx.Err(), "operation aborted during backoff")
+			}
 		}
-		api.rateLimiter.Wait(context.TODO())
+		err = api.rateLimiter.Wait(ctx)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error caused by request rate limiting")
+			return nil, nil, errors.Wrap(err, "Error caused by request rate limiting")
+		}
+		resp, respErr = api.request(ctx, method, uri, reqBody, authType, headers)
+		// a request which failed because ctx is done is not retried
+		if respErr != nil && ctx.Err() != nil {
+			return nil, nil, errors.Wrap(ctx.Err(), "request aborted")
 		}
-		resp, respErr = api.request(method, uri, reqBody, authType)
 
 		// retry if the server is rate limiting us or if it failed
 		// assumes server operations are rolled back on failure
@@ -150,77 +302,241 @@ func (api *API) makeRequestWithAuthType(method, uri string, params interface{},
 			// if we got a valid http response, try to read body so we can reuse the connection
 			// see https://golang.org/pkg/net/http/#Client.Do
 			if respErr == nil {
-				respBody, err = ioutil.ReadAll(resp.Body)
+				respBody, err = api.readBody(resp.Body)
 				resp.Body.Close()
 
 				respErr = errors.Wrap(err, "could not read response body")
 
 				api.logger.Printf("Request: %s %s got an error response %d: %s\n", method, uri, resp.StatusCode,
 					strings.Replace(strings.Replace(string(respBody), "\n", "", -1), "\t", "", -1))
+				retryErr = errors.Errorf("HTTP status %d", resp.StatusCode)
 			} else {
 				api.logger.Printf("Error performing request: %s %s : %s \n", method, uri, respErr.Error())
+				retryErr = respErr
 			}
 			continue
 		} else {
-			respBody, err = ioutil.ReadAll(resp.Body)
+			respBody, err = api.readBody(resp.Body)
 			defer resp.Body.Close()
 			if err != nil {
-				return nil, errors.Wrap(err, "could not read response body")
+				return nil, nil, errors.Wrap(err, "could not read response body")
 			}
 			break
 		}
 	}
 	if respErr != nil {
-		return nil, respErr
+		return nil, nil, respErr
 	}
+	api.lastMeta.set(newResponseMeta(resp))
 
 	switch {
 	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+	case resp.StatusCode == http.StatusNotModified:
+		return nil, resp.Header, ErrNotModified
 	case resp.StatusCode == http.StatusUnauthorized:
-		return nil, errors.Errorf("HTTP status %d: invalid credentials", resp.StatusCode)
+		return nil, nil, errors.WithStack(&RequestError{StatusCode: resp.StatusCode, Message: "invalid credentials"})
 	case resp.StatusCode == http.StatusForbidden:
-		return nil, errors.Errorf("HTTP status %d: insufficient permissions", resp.StatusCode)
+		return nil, nil, errors.WithStack(&RequestError{StatusCode: resp.StatusCode, Message: "insufficient permissions"})
 	case resp.StatusCode == http.StatusServiceUnavailable,
 		resp.StatusCode == http.StatusBadGateway,
 		resp.StatusCode == http.StatusGatewayTimeout,
 		resp.StatusCode == 522,
 		resp.StatusCode == 523,
 		resp.StatusCode == 524:
-		return nil, errors.Errorf("HTTP status %d: service failure", resp.StatusCode)
+		return nil, nil, errors.WithStack(&RequestError{StatusCode: resp.StatusCode, Message: "service failure"})
 	default:
+		// Only the "errors" of the response describe the failure; any
+		// informational "messages" are left out.
+		var r Response
+		if err := json.Unmarshal(respBody, &r); err == nil && len(r.Errors) > 0 {
+			msgs := make([]string, len(r.Errors))
+			for i, e := range r.Errors {
+				msgs[i] = e.String()
+			}
+			return nil, nil, errors.WithStack(&RequestError{StatusCode: resp.StatusCode, Message: strings.Join(msgs, "; ")})
+		}
 		var s string
 		if respBody != nil {
 			s = string(respBody)
 		}
-		return nil, errors.Errorf("HTTP status %d: content %q", resp.StatusCode, s)
+		return nil, nil, errors.WithStack(&RequestError{StatusCode: resp.StatusCode, Message: fmt.Sprintf("content %q", s)})
+	}
+
+	return respBody, resp.Header, nil
+}
+
+// ResponseMeta holds metadata of an API response which is not part of its
+// body. The rate limit fields are zero if the response carried no rate limit
+// headers.
+type ResponseMeta struct {
+	StatusCode int
+	// RayID identifies the request in Cloudflare's logs.
+	RayID string
+	// RateLimitLimit is the number of requests allowed in the current
+	// window and RateLimitRemaining the number still available.
+	RateLimitLimit     int
+	RateLimitRemaining int
+	// RateLimitReset is the time until the window resets.
+	RateLimitReset time.Duration
+}
+
+// newResponseMeta extracts the ResponseMeta of resp.
+func newResponseMeta(resp *http.Response) ResponseMeta {
+	atoi := func(header string) int {
+		n, _ := strconv.Atoi(resp.Header.Get(header))
+		return n
+	}
+	return ResponseMeta{
+		StatusCode:         resp.StatusCode,
+		RayID:              resp.Header.Get("Cf-Ray"),
+		RateLimitLimit:     atoi("X-RateLimit-Limit"),
+		RateLimitRemaining: atoi("X-RateLimit-Remaining"),
+		RateLimitReset:     time.Duration(atoi("X-RateLimit-Reset")) * time.Second,
 	}
+}
+
+// responseMetaStore holds the ResponseMeta of the last response received.
+type responseMetaStore struct {
+	mu   sync.Mutex
+	meta ResponseMeta
+}
+
+func (s *responseMetaStore) get() ResponseMeta {
+	if s == nil {
+		return ResponseMeta{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.meta
+}
+
+func (s *responseMetaStore) set(meta ResponseMeta) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.meta = meta
+}
+
+// LastResponseMeta returns the metadata, such as the rate limit headers, of
+// the last response received by the client, including error responses. When
+// the client is shared between goroutines the response may belong to any of
+// their requests.
+func (api *API) LastResponseMeta() ResponseMeta {
+	return api.lastMeta.get()
+}
+
+// ErrNotModified is returned together with the cached result by the helpers
+// supporting conditional requests when the resource did not change since it
+// was last fetched. See UsingETagCache.
+var ErrNotModified = errors.New("not modified")
+
+// etagEntry is a response body cached under its ETag.
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+// etagCache holds the last ETag tagged response of each URI.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
 
-	return respBody, nil
+func (c *etagCache) get(uri string) (etagEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[uri]
+	return e, ok
+}
+
+func (c *etagCache) set(uri string, e etagEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[uri] = e
+}
+
+// readBody reads a response body, failing once it exceeds the limit set with
+// UsingMaxResponseBytes.
+func (api *API) readBody(body io.Reader) ([]byte, error) {
+	if api.maxResponseBytes <= 0 {
+		return ioutil.ReadAll(body)
+	}
+	b, err := ioutil.ReadAll(&io.LimitedReader{R: body, N: api.maxResponseBytes + 1})
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > api.maxResponseBytes {
+		return nil, errors.Errorf("response body exceeds the limit of %d bytes", api.maxResponseBytes)
+	}
+	return b, nil
+}
+
+// unmarshal decodes a JSON response body into v. When strict JSON decoding is
+// enabled, fields in the body that v does not model are reported as errors.
+func (api *API) unmarshal(data []byte, v interface{}) error {
+	if !api.strictJSON {
+		return json.Unmarshal(data, v)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
 }
 
 // request makes a HTTP request to the given API endpoint, returning the raw
 // *http.Response, or an error if one occurred. The caller is responsible for
 // closing the response body.
-func (api *API) request(method, uri string, reqBody io.Reader, authType int) (*http.Response, error) {
+//
+// headers are applied on top of the user-defined headers, and may be nil.
+func (api *API) request(ctx context.Context, method, uri string, reqBody io.Reader, authType int, headers http.Header) (*http.Response, error) {
 	req, err := http.NewRequest(method, api.BaseURL+uri, reqBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request creation failed")
 	}
+	req = req.WithContext(ctx)
 
-	// Apply any user-defined headers first.
+	// Apply any user-defined headers first, followed by any headers for
+	// this request only.
 	req.Header = cloneHeader(api.headers)
+	for k, vs := range headers {
+		req.Header[k] = vs
+	}
+
+	creds := Credentials{
+		APIKey:            api.APIKey,
+		APIEmail:          api.APIEmail,
+		APIUserServiceKey: api.APIUserServiceKey,
+		APIToken:          api.APIToken,
+	}
+	if api.credentials != nil {
+		creds, err = api.credentials.Credentials(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve credentials")
+		}
+	}
 	if authType&AuthKeyEmail != 0 {
-		req.Header.Set("X-Auth-Key", api.APIKey)
-		req.Header.Set("X-Auth-Email", api.APIEmail)
+		if creds.APIToken != "" {
+			req.Header.Set("Authorization", "Bearer "+creds.APIToken)
+		} else {
+			req.Header.Set("X-Auth-Key", creds.APIKey)
+			req.Header.Set("X-Auth-Email", creds.APIEmail)
+		}
 	}
 	if authType&AuthUserService != 0 {
-		req.Header.Set("X-Auth-User-Service-Key", api.APIUserServiceKey)
+		req.Header.Set("X-Auth-User-Service-Key", creds.APIUserServiceKey)
 	}
 
 	if req.Header.Get("Content-Type") == "" {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	if api.signer != nil {
+		if err := api.signer.SignRequest(req); err != nil {
+			return nil, errors.Wrap(err, "request signing failed")
+		}
+	}
+
 	resp, err := api.httpClient.Do(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request failed")
@@ -258,21 +574,41 @@ type ResponseInfo struct {
 	Message string `json:"message"`
 }
 
+// String formats the info as "code: message".
+func (i ResponseInfo) String() string {
+	return strconv.Itoa(i.Code) + ": " + i.Message
+}
+
 // Response is a template.  There will also be a result struct.  There will be a
 // unique response type for each response, which will include this type.
 type Response struct {
-	Success  bool           `json:"success"`
-	Errors   []ResponseInfo `json:"errors"`
+	Success bool           `json:"success"`
+	Errors  []ResponseInfo `json:"errors"`
+	// Messages are informational, such as deprecation notices, and may be
+	// returned by successful calls. They never cause an error.
 	Messages []ResponseInfo `json:"messages"`
 }
 
 // ResultInfo contains metadata about the Response.
+//
+// Endpoints paginated by page number populate Page, PerPage, TotalPages,
+// Count and Total, the latter being the total_count of results across all
+// pages. Cursor paginated endpoints populate Cursor or Cursors instead.
 type ResultInfo struct {
-	Page       int `json:"page"`
-	PerPage    int `json:"per_page"`
-	TotalPages int `json:"total_pages"`
-	Count      int `json:"count"`
-	Total      int `json:"total_count"`
+	Page       int               `json:"page"`
+	PerPage    int               `json:"per_page"`
+	TotalPages int               `json:"total_pages"`
+	Count      int               `json:"count"`
+	Total      int               `json:"total_count"`
+	Cursor     string            `json:"cursor,omitempty"`
+	Cursors    ResultInfoCursors `json:"cursors"`
+}
+
+// ResultInfoCursors contains the cursors of the pages surrounding the
+// current page of a cursor paginated Response.
+type ResultInfoCursors struct {
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
 }
 
 // RawResponse keeps the result as JSON form
@@ -296,11 +632,35 @@ func (api *API) Raw(method, endpoint string, data interface{}) (json.RawMessage,
 	return r.Result, nil
 }
 
-// PaginationOptions can be passed to a list request to configure paging
-// These values will be defaulted if omitted, and PerPage has min/max limits set by resource
-type PaginationOptions struct {
-	Page    int `json:"page,omitempty"`
-	PerPage int `json:"per_page,omitempty"`
+// DoRaw sends an authenticated request to path, relative to the API base
+// URL, and returns the response as is, leaving its body unread so headers
+// can be inspected and the body streamed. body is encoded like the params of
+// the typed methods. The caller must close the response body.
+//
+// Unlike the typed methods, DoRaw neither retries the request nor treats an
+// error status as an error, and the client timeout does not apply, since the
+// body outlives the call; bound the request through ctx instead.
+func (api *API) DoRaw(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var reqBody io.Reader
+	if b, ok := body.([]byte); ok {
+		reqBody = bytes.NewReader(b)
+	} else if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "error marshalling params to JSON")
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	if err := api.rateLimiter.Wait(ctx); err != nil {
+		return nil, errors.Wrap(err, "Error caused by request rate limiting")
+	}
+	resp, err := api.request(ctx, method, path, reqBody, api.authType, nil)
+	if err != nil {
+		return nil, err
+	}
+	api.lastMeta.set(newResponseMeta(resp))
+	return resp, nil
 }
 
 // RetryPolicy specifies number of retries and min/max retry delays
@@ -309,6 +669,66 @@ type RetryPolicy struct {
 	MaxRetries    int
 	MinRetryDelay time.Duration
 	MaxRetryDelay time.Duration
+	// Jitter randomizes each delay between zero and its exponential backoff,
+	// so clients failing together do not retry together.
+	Jitter bool
+	// MaxElapsedTime, if positive, stops retrying once the next retry would
+	// start more than this long after the first attempt.
+	MaxElapsedTime time.Duration
+}
+
+// backoff returns the delay before the given retry attempt, starting at 1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	// nb time duration could truncate an arbitrary float. Since our inputs are all ints, we should be ok
+	d := time.Duration(math.Pow(2, float64(attempt-1)) * float64(p.MinRetryDelay))
+	if d > p.MaxRetryDelay {
+		d = p.MaxRetryDelay
+	}
+	if p.Jitter && d > 0 {
+		d = time.Duration(rand.Int63n(int64(d) + 1))
+	}
+	return d
+}
+
+// RequestSigner signs outgoing requests, e.g. for gateways fronting the API
+// which require HMAC signatures. SignRequest is called for every attempt of
+// a request once all other headers are set, and may modify the request. The
+// body can be read through req.GetBody without consuming it.
+type RequestSigner interface {
+	SignRequest(req *http.Request) error
+}
+
+// Credentials holds the secrets used to authenticate a request. When APIToken
+// is set it is sent as a bearer token instead of APIKey and APIEmail.
+type Credentials struct {
+	APIKey            string
+	APIEmail          string
+	APIUserServiceKey string
+	APIToken          string
+}
+
+// validate reports conflicting or incomplete credentials.
+func (c Credentials) validate() error {
+	if c.APIToken != "" {
+		if c.APIKey != "" || c.APIEmail != "" || c.APIUserServiceKey != "" {
+			return errors.New(errConflictingCreds)
+		}
+		return nil
+	}
+	if c.APIKey == "" && c.APIEmail == "" && c.APIUserServiceKey != "" {
+		return nil
+	}
+	if c.APIKey == "" || c.APIEmail == "" {
+		return errors.New(errEmptyCredentials)
+	}
+	return nil
+}
+
+// CredentialsProvider supplies the credentials for each request, allowing
+// them to be rotated without recreating the client. Credentials is called for
+// every attempt of a request and must be safe for concurrent use.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
 }
 
 // Logger defines the interface this library needs to use logging
@@ -316,3 +736,23 @@ type RetryPolicy struct {
 type Logger interface {
 	Printf(format string, v ...interface{})
 }
+
+// containsString reports whether s is one of list.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// containsInt reports whether i is one of list.
+func containsInt(list []int, i int) bool {
+	for _, v := range list {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
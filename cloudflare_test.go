@@ -1,10 +1,19 @@
 package cloudflare
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -37,6 +46,34 @@ func teardown() {
 	server.Close()
 }
 
+// slowHandler returns a handler which holds each request for delay before
+// replying with body. It returns early once the client goes away, so tests
+// cancelling a request do not wait for delay to pass.
+func slowHandler(delay time.Duration, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// the server only notices a closed connection once the request body
+		// has been consumed
+		ioutil.ReadAll(r.Body)
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, body)
+	}
+}
+
+// assertAbortedPromptly asserts that err was caused by want and that the call
+// returned well before a slowHandler holding requests for delay would have
+// replied.
+func assertAbortedPromptly(t *testing.T, err error, want error, elapsed, delay time.Duration) {
+	if assert.Error(t, err) {
+		assert.True(t, errors.Is(err, want), "expected %v, got %v", want, err)
+	}
+	assert.True(t, elapsed < delay/2, "call returned after %s", elapsed)
+}
+
 func TestClient_Headers(t *testing.T) {
 	// it should set default headers
 	setup()
@@ -201,3 +238,557 @@ func TestClient_RetryReturnsPersistentErrorResponse(t *testing.T) {
 	_, err := client.ListLoadBalancerPools()
 	assert.Error(t, err)
 }
+
+func TestClient_OnRetry(t *testing.T) {
+	setup(UsingRetryPolicy(3, 0, 1))
+	defer teardown()
+
+	var attempts []int
+	var errs []string
+	client.OnRetry = func(attempt int, err error, wait time.Duration) {
+		attempts = append(attempts, attempt)
+		errs = append(errs, err.Error())
+		assert.True(t, wait <= time.Second)
+	}
+
+	requestsReceived := 0
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch requestsReceived {
+		case 0:
+			w.WriteHeader(500)
+			fmt.Fprint(w, `{"success": false, "errors": [], "messages": [], "result": null}`)
+		case 1:
+			w.WriteHeader(429)
+			fmt.Fprint(w, `{"success": false, "errors": [], "messages": [], "result": null}`)
+		default:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com"}}`)
+		}
+		requestsReceived++
+	})
+
+	_, err := client.CustomHostname("foo", "bar")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []int{1, 2}, attempts)
+		assert.Equal(t, []string{"HTTP status 500", "HTTP status 429"}, errs)
+	}
+}
+
+func TestClient_MaxResponseBytes(t *testing.T) {
+	setup(UsingMaxResponseBytes(64))
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "%s.example.com"}}`, strings.Repeat("a", 64))
+	})
+
+	_, err := client.CustomHostname("foo", "bar")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "response body exceeds the limit of 64 bytes")
+	}
+}
+
+func TestClient_MaxResponseBytesWithinLimit(t *testing.T) {
+	setup(UsingMaxResponseBytes(1024))
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com"}}`)
+	})
+
+	ch, err := client.CustomHostname("foo", "bar")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "app.example.com", ch.Hostname)
+	}
+}
+
+type hmacSigner struct {
+	key   []byte
+	calls int
+}
+
+func (s *hmacSigner) SignRequest(req *http.Request) error {
+	s.calls++
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(req.Method + " " + req.URL.Path + " " + req.Header.Get("X-Auth-Email")))
+	req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+func TestClient_RequestSigner(t *testing.T) {
+	signer := &hmacSigner{key: []byte("secret")}
+	setup(UsingRequestSigner(signer))
+	defer teardown()
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("GET /user cloudflare@example.org"))
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, want, r.Header.Get("X-Signature"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err := client.UserDetails()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, signer.calls)
+}
+
+type rotatingCredentials struct {
+	tokens []string
+	calls  int
+}
+
+func (p *rotatingCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	token := p.tokens[p.calls%len(p.tokens)]
+	p.calls++
+	return Credentials{APIToken: token}, nil
+}
+
+func TestClient_CredentialsProvider(t *testing.T) {
+	provider := &rotatingCredentials{tokens: []string{"first-token", "second-token"}}
+	setup(UsingCredentialsProvider(provider))
+	defer teardown()
+
+	var seen []string
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("Authorization"))
+		assert.Equal(t, "", r.Header.Get("X-Auth-Key"))
+		assert.Equal(t, "", r.Header.Get("X-Auth-Email"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err := client.UserDetails()
+	assert.NoError(t, err)
+	_, err = client.UserDetails()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Bearer first-token", "Bearer second-token"}, seen)
+}
+
+type failingCredentials struct{}
+
+func (failingCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	return Credentials{}, errors.New("secret unavailable")
+}
+
+func TestClient_CredentialsProviderError(t *testing.T) {
+	setup(UsingCredentialsProvider(failingCredentials{}), UsingRetryPolicy(0, 0, 0))
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent without credentials")
+	})
+
+	_, err := client.UserDetails()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "secret unavailable")
+	}
+}
+
+func TestClient_RequestHeaders(t *testing.T) {
+	headers := make(http.Header)
+	headers.Set("X-Random", "a default header")
+	headers.Set("X-Shared", "default")
+	setup(Headers(headers))
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "a default header", r.Header.Get("X-Random"))
+		assert.Equal(t, "per request", r.Header.Get("X-Shared"))
+		assert.Equal(t, "value", r.Header.Get("X-Per-Request"))
+		// authentication must not be overwritten by per-request headers
+		assert.Equal(t, "deadbeef", r.Header.Get("X-Auth-Key"))
+		assert.Equal(t, "cloudflare@example.org", r.Header.Get("X-Auth-Email"))
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	perRequest := make(http.Header)
+	perRequest.Set("X-Shared", "per request")
+	perRequest.Set("X-Per-Request", "value")
+	perRequest.Set("X-Auth-Key", "overridden")
+	_, err := client.makeRequestWithHeaders("GET", "/user", nil, perRequest)
+	assert.NoError(t, err)
+
+	// the per-request headers must not leak into the client defaults
+	assert.Equal(t, "default", headers.Get("X-Shared"))
+	assert.Empty(t, headers.Get("X-Per-Request"))
+}
+
+func TestClient_RequestHeadersWithAuthType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		// credentials not covered by the auth type may be provided explicitly
+		assert.Equal(t, "userservicekey", r.Header.Get("X-Auth-User-Service-Key"))
+		assert.Equal(t, "explicit", r.Header.Get("X-Auth-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-Email"))
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	client.APIUserServiceKey = "userservicekey"
+	perRequest := make(http.Header)
+	perRequest.Set("X-Auth-Key", "explicit")
+	_, err := client.makeRequestWithAuthTypeAndHeaders(context.Background(), "GET", "/certificates", nil, AuthUserService, perRequest)
+	assert.NoError(t, err)
+}
+
+func TestClient_StrictJSON(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "023e105f4ecef8ad9ca31a8372d0c353",
+            "name": "example.com",
+            "an_unexpected_field": true
+          }
+        }`)
+	}
+
+	// unknown fields are ignored by default
+	setup()
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", handler)
+	zone, err := client.ZoneDetails("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "example.com", zone.Name)
+	}
+	teardown()
+
+	// and reported in strict mode
+	setup(UsingStrictJSON(true))
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", handler)
+	_, err = client.ZoneDetails("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `unknown field "an_unexpected_field"`)
+	}
+	teardown()
+}
+
+func TestClient_LastResponseMeta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assert.Equal(t, ResponseMeta{}, client.LastResponseMeta())
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("Cf-Ray", "4c0f6b8d0a7e2f1c-SJC")
+		w.Header().Set("X-RateLimit-Limit", "1200")
+		w.Header().Set("X-RateLimit-Remaining", "1187")
+		w.Header().Set("X-RateLimit-Reset", "240")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [],
+          "result_info": {"page": 1, "per_page": 50, "count": 0, "total_count": 0, "total_pages": 1}
+        }`)
+	})
+
+	_, _, err := client.CustomHostnames("foo", 1, CustomHostname{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ResponseMeta{
+			StatusCode:         http.StatusOK,
+			RayID:              "4c0f6b8d0a7e2f1c-SJC",
+			RateLimitLimit:     1200,
+			RateLimitRemaining: 1187,
+			RateLimitReset:     240 * time.Second,
+		}, client.LastResponseMeta())
+	}
+}
+
+func TestClient_ResponseMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [{"code": 10000, "message": "This endpoint will be deprecated soon."}],
+          "result": {"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "www.example.com", "content": "198.51.100.4"}
+        }`)
+	})
+
+	res, err := client.CreateDNSRecord("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{Type: "A", Name: "www.example.com", Content: "198.51.100.4"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ResponseInfo{{Code: 10000, Message: "This endpoint will be deprecated soon."}}, res.Messages)
+		assert.Empty(t, res.Errors)
+	}
+}
+
+func TestClient_ErrorFromResponseErrorsOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+          "success": false,
+          "errors": [{"code": 81057, "message": "The record already exists."}],
+          "messages": [{"code": 10000, "message": "This endpoint will be deprecated soon."}],
+          "result": null
+        }`)
+	})
+
+	_, err := client.CreateDNSRecord("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{Type: "A", Name: "www.example.com", Content: "198.51.100.4"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "HTTP status 400: 81057: The record already exists.")
+		assert.NotContains(t, err.Error(), "deprecated")
+
+		var reqErr *RequestError
+		if assert.True(t, errors.As(err, &reqErr), "expected a *RequestError, got %v", err) {
+			assert.Equal(t, http.StatusBadRequest, reqErr.StatusCode)
+			assert.Equal(t, "81057: The record already exists.", reqErr.Message)
+		}
+	}
+}
+
+func TestClient_Timeout(t *testing.T) {
+	setup(UsingTimeout(50 * time.Millisecond))
+	defer teardown()
+
+	delay := 5 * time.Second
+	mux.HandleFunc("/user", slowHandler(delay, `{"success": true, "errors": [], "messages": [], "result": {}}`))
+
+	start := time.Now()
+	_, err := client.UserDetails()
+	assertAbortedPromptly(t, err, context.DeadlineExceeded, time.Since(start), delay)
+}
+
+func TestClient_TimeoutKeepsContextDeadline(t *testing.T) {
+	setup(UsingTimeout(time.Millisecond))
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+		slowHandler(50*time.Millisecond, `{"success": true, "errors": [], "messages": [], "result": {"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9"}}`))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ch, err := client.CustomHostnameContext(ctx, "023e105f4ecef8ad9ca31a8372d0c353", "0d89c70d-ad9f-4843-b99f-6cc0252067e9")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0d89c70d-ad9f-4843-b99f-6cc0252067e9", ch.ID)
+	}
+}
+
+func TestClient_CancelNotRetried(t *testing.T) {
+	setup(UsingRetryPolicy(3, 1, 1))
+	defer teardown()
+
+	var attempts int32
+	delay := 5 * time.Second
+	handler := slowHandler(delay, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		handler(w, r)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := client.DeleteCustomHostnameContext(ctx, "023e105f4ecef8ad9ca31a8372d0c353", "0d89c70d-ad9f-4843-b99f-6cc0252067e9")
+	assertAbortedPromptly(t, err, context.Canceled, time.Since(start), delay)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestClient_DoRaw(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "cloudflare@example.org", r.Header.Get("X-Auth-Email"))
+		assert.Equal(t, "deadbeef", r.Header.Get("X-Auth-Key"))
+		b, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"hostname": "app.example.com"}`, string(b))
+		}
+		w.Header().Set("Location", "/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames/bar")
+		w.Header().Set("X-RateLimit-Remaining", "1199")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar"}}`)
+	})
+
+	resp, err := client.DoRaw(context.Background(), "POST", "/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames", map[string]string{"hostname": "app.example.com"})
+	if assert.NoError(t, err) {
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames/bar", resp.Header.Get("Location"))
+		assert.Equal(t, "1199", resp.Header.Get("X-RateLimit-Remaining"))
+		b, err := ioutil.ReadAll(resp.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar"}}`, string(b))
+		}
+		assert.Equal(t, 1199, client.LastResponseMeta().RateLimitRemaining)
+	}
+}
+
+func TestClient_DoRawErrorStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	resp, err := client.DoRaw(context.Background(), "GET", "/zones/023e105f4ecef8ad9ca31a8372d0c353", nil)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 1, requests)
+	}
+}
+
+func TestNewWithCredentials_Invalid(t *testing.T) {
+	for name, creds := range map[string]Credentials{
+		"empty":                      {},
+		"key without email":          {APIKey: "deadbeef"},
+		"email without key":          {APIEmail: "cloudflare@example.org"},
+		"token and key":              {APIToken: "abc123", APIKey: "deadbeef"},
+		"token and email":            {APIToken: "abc123", APIEmail: "cloudflare@example.org"},
+		"token, key and email":       {APIToken: "abc123", APIKey: "deadbeef", APIEmail: "cloudflare@example.org"},
+		"token and user service key": {APIToken: "abc123", APIUserServiceKey: "v1.0-deadbeef"},
+		"key and user service key":   {APIKey: "deadbeef", APIUserServiceKey: "v1.0-deadbeef"},
+	} {
+		_, err := NewWithCredentials(creds)
+		assert.Error(t, err, name)
+	}
+
+	_, err := New("deadbeef", "")
+	assert.EqualError(t, err, errEmptyCredentials)
+}
+
+func TestNewWithAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client, err := NewWithAPIToken("abc123", UsingRetryPolicy(0, 0, 0))
+	if !assert.NoError(t, err) {
+		return
+	}
+	client.BaseURL = server.URL
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer abc123", r.Header.Get("Authorization"))
+		assert.Equal(t, "", r.Header.Get("X-Auth-Key"))
+		assert.Equal(t, "", r.Header.Get("X-Auth-Email"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err = client.UserDetails()
+	assert.NoError(t, err)
+}
+
+func TestNewWithCredentials_UserServiceKey(t *testing.T) {
+	api, err := NewWithCredentials(Credentials{APIUserServiceKey: "v1.0-deadbeef"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, AuthUserService, api.authType)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MinRetryDelay: 100 * time.Millisecond, MaxRetryDelay: time.Second}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 400*time.Millisecond, p.backoff(3))
+	assert.Equal(t, time.Second, p.backoff(10))
+
+	p.Jitter = true
+	for attempt := 1; attempt <= 10; attempt++ {
+		ceiling := RetryPolicy{MinRetryDelay: p.MinRetryDelay, MaxRetryDelay: p.MaxRetryDelay}.backoff(attempt)
+		varied := false
+		for i := 0; i < 100; i++ {
+			d := p.backoff(attempt)
+			assert.True(t, d >= 0 && d <= ceiling, "attempt %d: delay %s outside [0, %s]", attempt, d, ceiling)
+			if d != ceiling {
+				varied = true
+			}
+		}
+		assert.True(t, varied, "attempt %d: delay was never randomized", attempt)
+	}
+}
+
+func TestClient_RetryMaxElapsedTime(t *testing.T) {
+	setup(UsingRetryPolicy(100, 0, 0), UsingRetryMaxElapsedTime(50*time.Millisecond))
+	defer teardown()
+	client.retryPolicy.MinRetryDelay = 20 * time.Millisecond
+	client.retryPolicy.MaxRetryDelay = 20 * time.Millisecond
+
+	requestsReceived := 0
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		requestsReceived++
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(500)
+		fmt.Fprint(w, `{"success": false, "errors": [], "messages": [], "result": null}`)
+	})
+
+	start := time.Now()
+	_, err := client.CustomHostname("foo", "bar")
+	elapsed := time.Since(start)
+	assert.Error(t, err)
+	assert.True(t, requestsReceived >= 2 && requestsReceived <= 4, "got %d requests", requestsReceived)
+	assert.True(t, elapsed < 500*time.Millisecond, "gave up after %s", elapsed)
+}
+
+func TestUsingRetryPolicy_KeepsJitterAndMaxElapsedTime(t *testing.T) {
+	api, err := New("deadbeef", "cloudflare@example.org",
+		UsingRetryJitter(true), UsingRetryMaxElapsedTime(time.Minute), UsingRetryPolicy(5, 1, 10))
+	if assert.NoError(t, err) {
+		assert.Equal(t, RetryPolicy{
+			MaxRetries:     5,
+			MinRetryDelay:  time.Second,
+			MaxRetryDelay:  10 * time.Second,
+			Jitter:         true,
+			MaxElapsedTime: time.Minute,
+		}, api.retryPolicy)
+	}
+}
+
+func TestZoneIDByHostname(t *testing.T) {
+	setup()
+	defer teardown()
+
+	zones := map[string]string{
+		"example.com":     `{"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com"}`,
+		"sub.example.com": `{"id": "9a7806061c88ada191ed06f989cc3dac", "name": "sub.example.com"}`,
+	}
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		result := "[]"
+		if zone, ok := zones[r.URL.Query().Get("name")]; ok {
+			result = "[" + zone + "]"
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, result)
+	})
+
+	id, err := client.ZoneIDByHostname("www.sub.example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "9a7806061c88ada191ed06f989cc3dac", id)
+	}
+
+	id, err = client.ZoneIDByHostname("www.example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", id)
+	}
+
+	id, err = client.ZoneIDByHostname("Sub.Example.com.")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "9a7806061c88ada191ed06f989cc3dac", id)
+	}
+
+	_, err = client.ZoneIDByHostname("www.example.net")
+	assert.Error(t, err)
+}
@@ -0,0 +1,47 @@
+package cloudflaretest_test
+
+import (
+	"fmt"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/cloudflare-go/cloudflaretest"
+)
+
+func ExampleServer_HandleResult() {
+	s, err := cloudflaretest.NewServer()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer s.Close()
+
+	s.HandleResult("POST", "/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames", cloudflare.CustomHostname{
+		ID:       "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+		Hostname: "app.example.com",
+		Status:   cloudflare.CustomHostnameStatusPending,
+	})
+
+	// Code under test receives s.API in place of a real client.
+	res, err := s.API.CreateCustomHostname("023e105f4ecef8ad9ca31a8372d0c353", cloudflare.CustomHostname{Hostname: "app.example.com"})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(res.Result.ID, res.Result.Status)
+	// Output: 0d89c70d-ad9f-4843-b99f-6cc0252067e9 pending
+}
+
+func ExampleServer_HandleError() {
+	s, err := cloudflaretest.NewServer()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer s.Close()
+
+	s.HandleError("POST", "/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames", 409, 1406, "Duplicate custom hostname found.")
+
+	_, err = s.API.CreateCustomHostname("023e105f4ecef8ad9ca31a8372d0c353", cloudflare.CustomHostname{Hostname: "app.example.com"})
+	fmt.Println(err != nil)
+	// Output: true
+}
@@ -0,0 +1,101 @@
+// Package cloudflaretest provides a stub Cloudflare API server for testing
+// code built on the cloudflare package, in the spirit of net/http/httptest.
+package cloudflaretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// APIToken is the token the client returned by NewServer authenticates with.
+const APIToken = "cloudflaretest-token"
+
+// Server is a stub Cloudflare API. Handlers registered on Mux, or through
+// HandleResult and HandleError, answer the requests made by API.
+type Server struct {
+	*httptest.Server
+	Mux *http.ServeMux
+	API *cloudflare.API
+}
+
+// NewServer starts a stub API and returns it along with a client pointed at
+// it. The client authenticates with APIToken and neither rate limits nor
+// retries requests; opts are applied after these defaults. The caller must
+// call Close when done.
+func NewServer(opts ...cloudflare.Option) (*Server, error) {
+	mux := http.NewServeMux()
+	s := &Server{Server: httptest.NewServer(mux), Mux: mux}
+
+	opts = append([]cloudflare.Option{cloudflare.UsingRateLimit(100000), cloudflare.UsingRetryPolicy(0, 0, 0)}, opts...)
+	api, err := cloudflare.NewWithAPIToken(APIToken, opts...)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	api.BaseURL = s.URL
+	s.API = api
+	return s, nil
+}
+
+// HandleResult answers method requests to path with a successful API response
+// carrying result, encoded as JSON. Requests with another method or without
+// the APIToken credentials are answered with an API error.
+func (s *Server) HandleResult(method, path string, result interface{}) {
+	s.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if !checkRequest(w, r, method) {
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"success":  true,
+			"errors":   []interface{}{},
+			"messages": []interface{}{},
+			"result":   result,
+		})
+	})
+}
+
+// HandleError answers method requests to path with a failed API response of
+// the given HTTP status, carrying a single error with code and message.
+func (s *Server) HandleError(method, path string, status, code int, message string) {
+	s.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if !checkRequest(w, r, method) {
+			return
+		}
+		writeError(w, status, code, message)
+	})
+}
+
+// checkRequest answers r with an API error unless it uses method and carries
+// the APIToken credentials, and reports whether it passed.
+func checkRequest(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Header.Get("Authorization") != "Bearer "+APIToken {
+		writeError(w, http.StatusBadRequest, 6003, "Invalid request headers")
+		return false
+	}
+	if r.Method != method {
+		writeError(w, http.StatusMethodNotAllowed, 10000, fmt.Sprintf("method %s not allowed, expected %s", r.Method, method))
+		return false
+	}
+	return true
+}
+
+// writeError writes a failed API response.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"success":  false,
+		"errors":   []map[string]interface{}{{"code": code, "message": message}},
+		"messages": []interface{}{},
+		"result":   nil,
+	})
+}
+
+// writeJSON writes v as the JSON body of a response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
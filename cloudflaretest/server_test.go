@@ -0,0 +1,44 @@
+package cloudflaretest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_HandleResultRejectsWrongMethod(t *testing.T) {
+	s, err := NewServer()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Close()
+
+	s.HandleResult("POST", "/zones/foo/custom_hostnames", cloudflare.CustomHostname{ID: "bar"})
+
+	resp, err := s.API.DoRaw(context.Background(), "GET", "/zones/foo/custom_hostnames", nil)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	}
+	_, err = s.API.CreateCustomHostname("foo", cloudflare.CustomHostname{Hostname: "app.example.com"})
+	assert.NoError(t, err)
+}
+
+func TestServer_HandleResultRequiresAuth(t *testing.T) {
+	s, err := NewServer()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Close()
+
+	s.HandleResult("GET", "/zones/foo/custom_hostnames/bar", cloudflare.CustomHostname{ID: "bar"})
+
+	resp, err := http.Get(s.URL + "/zones/foo/custom_hostnames/bar")
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
@@ -119,6 +119,10 @@ func main() {
 							Name:  "org-id",
 							Usage: "organization ID",
 						},
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "zone type: full, partial or secondary",
+						},
 					},
 				},
 				{
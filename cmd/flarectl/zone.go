@@ -29,11 +29,12 @@ func zoneCreate(c *cli.Context) {
 	zone := c.String("zone")
 	jumpstart := c.Bool("jumpstart")
 	orgID := c.String("org-id")
+	zoneType := c.String("type")
 	var org cloudflare.Organization
 	if orgID != "" {
 		org.ID = orgID
 	}
-	api.CreateZone(zone, jumpstart, org)
+	api.CreateZone(zone, jumpstart, org, zoneType)
 }
 
 func zoneCheck(c *cli.Context) {
@@ -151,7 +152,7 @@ func zoneCachePurge(c *cli.Context) {
 
 	// Purge everything
 	if c.Bool("everything") {
-		resp, err = api.PurgeEverything(zoneID)
+		resp, err = api.PurgeEverything(zoneID, true)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error purging all from zone %q: %s\n", zoneName, err)
 			return
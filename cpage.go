@@ -1,9 +1,35 @@
 package cloudflare
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Custom page states.
+const (
+	CustomPageStateDefault    = "default"
+	CustomPageStateCustomized = "customized"
+)
+
+// customPageIDs are the types of pages which can be customized per zone.
+var customPageIDs = []string{
+	"basic_challenge",
+	"waf_challenge",
+	"waf_block",
+	"ratelimit_block",
+	"country_challenge",
+	"ip_block",
+	"under_attack",
+	"500_errors",
+	"1000_errors",
+	"always_online",
+}
 
 // CustomPage represents a custom page configuration.
 type CustomPage struct {
+	ID             string    `json:"id"`
 	CreatedOn      string    `json:"created_on"`
 	ModifiedOn     time.Time `json:"modified_on"`
 	URL            string    `json:"url"`
@@ -19,11 +45,77 @@ type CustomPageResponse struct {
 	Result []CustomPage `json:"result"`
 }
 
-// https://api.cloudflare.com/#custom-pages-for-a-zone-available-custom-pages
-// GET /zones/:zone_identifier/custom_pages
+// CustomPageDetailResponse represents the response from the custom page
+// endpoints containing a single page.
+type CustomPageDetailResponse struct {
+	Response
+	Result CustomPage `json:"result"`
+}
 
-// https://api.cloudflare.com/#custom-pages-for-a-zone-custom-page-details
-// GET /zones/:zone_identifier/custom_pages/:identifier
+// CustomPages lists the custom pages of the given zone.
+//
+// API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-available-custom-pages
+func (api *API) CustomPages(zoneID string) ([]CustomPage, error) {
+	uri := "/zones/" + zoneID + "/custom_pages"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []CustomPage{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r CustomPageResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []CustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CustomPage returns a single custom page of the given zone, identified by
+// its type, e.g. "waf_block" or "500_errors".
+//
+// API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-custom-page-details
+func (api *API) CustomPage(zoneID, customPageID string) (CustomPage, error) {
+	uri := "/zones/" + zoneID + "/custom_pages/" + customPageID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return CustomPage{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r CustomPageDetailResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return CustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
 
-// https://api.cloudflare.com/#custom-pages-for-a-zone-update-custom-page-url
-// PUT /zones/:zone_identifier/custom_pages/:identifier
+// UpdateCustomPage points a custom page of the given zone at url, which
+// Cloudflare fetches the page from, and sets its state. Use
+// CustomPageStateCustomized with a URL, or CustomPageStateDefault with an
+// empty URL to reset the page to Cloudflare's default.
+//
+// API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-update-custom-page-url
+func (api *API) UpdateCustomPage(zoneID, customPageID, url, state string) (CustomPage, error) {
+	if !containsString(customPageIDs, customPageID) {
+		return CustomPage{}, errors.Errorf("invalid custom page %q: must be one of %s", customPageID, strings.Join(customPageIDs, ", "))
+	}
+	switch state {
+	case CustomPageStateDefault:
+	case CustomPageStateCustomized:
+		if url == "" {
+			return CustomPage{}, errors.New("customized page requires a URL")
+		}
+	default:
+		return CustomPage{}, errors.Errorf("invalid custom page state %q: must be one of %s, %s", state, CustomPageStateDefault, CustomPageStateCustomized)
+	}
+
+	uri := "/zones/" + zoneID + "/custom_pages/" + customPageID
+	res, err := api.makeRequest("PUT", uri, struct {
+		URL   string `json:"url"`
+		State string `json:"state"`
+	}{url, state})
+	if err != nil {
+		return CustomPage{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r CustomPageDetailResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return CustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,136 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_pages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "waf_block",
+              "created_on": "2014-01-01T05:20:00.12345Z",
+              "modified_on": "2014-01-01T05:20:00.12345Z",
+              "url": "",
+              "state": "default",
+              "required_tokens": ["::CLOUDFLARE_ERROR_1000S_BOX::"],
+              "preview_target": "block:basic-sec-captcha",
+              "description": "WAF Block"
+            }
+          ]
+        }`)
+	})
+
+	pages, err := client.CustomPages("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(pages)) {
+		assert.Equal(t, "waf_block", pages[0].ID)
+		assert.Equal(t, CustomPageStateDefault, pages[0].State)
+		assert.Equal(t, []string{"::CLOUDFLARE_ERROR_1000S_BOX::"}, pages[0].RequiredTokens)
+	}
+}
+
+func TestCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_pages/500_errors", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "500_errors", "url": "https://example.com/500.html", "state": "customized", "description": "500 Class Errors"}
+        }`)
+	})
+
+	page, err := client.CustomPage("023e105f4ecef8ad9ca31a8372d0c353", "500_errors")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://example.com/500.html", page.URL)
+		assert.Equal(t, CustomPageStateCustomized, page.State)
+	}
+}
+
+func TestUpdateCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_pages/waf_block", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"url": "https://example.com/blocked.html", "state": "customized"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "waf_block", "url": "https://example.com/blocked.html", "state": "customized"}
+        }`)
+	})
+
+	page, err := client.UpdateCustomPage("023e105f4ecef8ad9ca31a8372d0c353", "waf_block", "https://example.com/blocked.html", CustomPageStateCustomized)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://example.com/blocked.html", page.URL)
+		assert.Equal(t, CustomPageStateCustomized, page.State)
+	}
+}
+
+func TestUpdateCustomPageResetToDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_pages/waf_block", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"url": "", "state": "default"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "waf_block", "url": "", "state": "default"}
+        }`)
+	})
+
+	page, err := client.UpdateCustomPage("023e105f4ecef8ad9ca31a8372d0c353", "waf_block", "", CustomPageStateDefault)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "", page.URL)
+		assert.Equal(t, CustomPageStateDefault, page.State)
+	}
+}
+
+func TestUpdateCustomPageInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateCustomPage("023e105f4ecef8ad9ca31a8372d0c353", "404_errors", "", CustomPageStateDefault)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `invalid custom page "404_errors": must be one of basic_challenge`)
+	}
+
+	_, err = client.UpdateCustomPage("023e105f4ecef8ad9ca31a8372d0c353", "waf_block", "", "enabled")
+	assert.EqualError(t, err, `invalid custom page state "enabled": must be one of default, customized`)
+
+	_, err = client.UpdateCustomPage("023e105f4ecef8ad9ca31a8372d0c353", "waf_block", "", CustomPageStateCustomized)
+	assert.EqualError(t, err, "customized page requires a URL")
+}
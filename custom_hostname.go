@@ -1,31 +1,346 @@
 package cloudflare
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"net/url"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// Custom hostname SSL statuses, as reported in CustomHostnameSSL.Status.
+const (
+	CustomHostnameSSLStatusInitializing         = "initializing"
+	CustomHostnameSSLStatusPendingValidation    = "pending_validation"
+	CustomHostnameSSLStatusDeleted              = "deleted"
+	CustomHostnameSSLStatusPendingIssuance      = "pending_issuance"
+	CustomHostnameSSLStatusPendingDeployment    = "pending_deployment"
+	CustomHostnameSSLStatusPendingDeletion      = "pending_deletion"
+	CustomHostnameSSLStatusPendingExpiration    = "pending_expiration"
+	CustomHostnameSSLStatusExpired              = "expired"
+	CustomHostnameSSLStatusActive               = "active"
+	CustomHostnameSSLStatusInitializingTimedOut = "initializing_timed_out"
+	CustomHostnameSSLStatusValidationTimedOut   = "validation_timed_out"
+	CustomHostnameSSLStatusIssuanceTimedOut     = "issuance_timed_out"
+	CustomHostnameSSLStatusDeploymentTimedOut   = "deployment_timed_out"
+	CustomHostnameSSLStatusDeletionTimedOut     = "deletion_timed_out"
+	CustomHostnameSSLStatusPendingCleanup       = "pending_cleanup"
+	CustomHostnameSSLStatusStagingDeployment    = "staging_deployment"
+	CustomHostnameSSLStatusStagingActive        = "staging_active"
+	CustomHostnameSSLStatusDeactivating         = "deactivating"
+	CustomHostnameSSLStatusInactive             = "inactive"
+	CustomHostnameSSLStatusBackupIssued         = "backup_issued"
+	CustomHostnameSSLStatusHoldingDeployment    = "holding_deployment"
+)
+
+// Custom hostname statuses, as reported in CustomHostname.Status.
+const (
+	CustomHostnameStatusActive             = "active"
+	CustomHostnameStatusPending            = "pending"
+	CustomHostnameStatusActiveRedeploying  = "active_redeploying"
+	CustomHostnameStatusMoved              = "moved"
+	CustomHostnameStatusPendingDeletion    = "pending_deletion"
+	CustomHostnameStatusDeleted            = "deleted"
+	CustomHostnameStatusPendingBlocked     = "pending_blocked"
+	CustomHostnameStatusPendingMigration   = "pending_migration"
+	CustomHostnameStatusPendingProvisioned = "pending_provisioned"
+	CustomHostnameStatusTestPending        = "test_pending"
+	CustomHostnameStatusTestActive         = "test_active"
+	CustomHostnameStatusTestActiveApex     = "test_active_apex"
+	CustomHostnameStatusTestBlocked        = "test_blocked"
+	CustomHostnameStatusTestFailed         = "test_failed"
+	CustomHostnameStatusProvisioned        = "provisioned"
+	CustomHostnameStatusBlocked            = "blocked"
+)
+
+// CustomHostnameSSLTypeDV is the only certificate type documented for custom
+// hostnames, a domain validated certificate. It is the default type of
+// custom hostnames created with SSL.
+const CustomHostnameSSLTypeDV = "dv"
+
+// ErrInvalidSSLType is returned when creating or updating a custom hostname
+// whose CustomHostnameSSL.Type is not a documented certificate type.
+var ErrInvalidSSLType = errors.New("invalid custom hostname SSL type: must be dv")
+
 // CustomHostnameSSL represents the SSL section in a given custom hostname.
 type CustomHostnameSSL struct {
-	Status      string `json:"status,omitempty"`
-	Method      string `json:"method,omitempty"`
-	Type        string `json:"type,omitempty"`
-	CnameTarget string `json:"cname_target,omitempty"`
-	CnameName   string `json:"cname_name,omitempty"`
+	Status           string                             `json:"status,omitempty"`
+	Method           string                             `json:"method,omitempty"`
+	Type             string                             `json:"type,omitempty"`
+	CnameTarget      string                             `json:"cname_target,omitempty"`
+	CnameName        string                             `json:"cname_name,omitempty"`
+	Settings         *CustomHostnameSSLSettings         `json:"settings,omitempty"`
+	ValidationErrors []CustomHostnameSSLValidationError `json:"validation_errors,omitempty"`
+	// ValidationRecords is read-only and describes how to complete domain
+	// control validation with the chosen Method.
+	ValidationRecords []CustomHostnameSSLValidationRecord `json:"validation_records,omitempty"`
+
+	// Issuer, SerialNumber, Signature, UploadedOn and ExpiresOn are read-only
+	// and describe the active certificate once one has been issued.
+	Issuer       string     `json:"issuer,omitempty"`
+	SerialNumber string     `json:"serial_number,omitempty"`
+	Signature    string     `json:"signature,omitempty"`
+	UploadedOn   *time.Time `json:"uploaded_on,omitempty"`
+	ExpiresOn    *time.Time `json:"expires_on,omitempty"`
+}
+
+// validateType returns ErrInvalidSSLType unless Type is empty or a
+// documented certificate type.
+func (s CustomHostnameSSL) validateType() error {
+	switch s.Type {
+	case "", CustomHostnameSSLTypeDV:
+		return nil
+	}
+	return ErrInvalidSSLType
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The API reports the SSL of some
+// hostnames without SSL configured as an empty string rather than an object;
+// it is read, like null, as a zero CustomHostnameSSL.
+func (s *CustomHostnameSSL) UnmarshalJSON(data []byte) error {
+	switch strings.TrimSpace(string(data)) {
+	case `""`, "null":
+		*s = CustomHostnameSSL{}
+		return nil
+	}
+	type customHostnameSSL CustomHostnameSSL
+	var ssl customHostnameSSL
+	if err := json.Unmarshal(data, &ssl); err != nil {
+		return err
+	}
+	*s = CustomHostnameSSL(ssl)
+	return nil
+}
+
+// CustomHostnameSSLSettings holds the per-hostname TLS settings of a custom
+// hostname. The toggles take "on" or "off"; empty fields are omitted and keep
+// the zone's behaviour.
+type CustomHostnameSSLSettings struct {
+	HTTP2         string   `json:"http2,omitempty"`
+	TLS13         string   `json:"tls_1_3,omitempty"`
+	MinTLSVersion string   `json:"min_tls_version,omitempty"`
+	Ciphers       []string `json:"ciphers,omitempty"`
+	EarlyHints    string   `json:"early_hints,omitempty"`
+}
+
+// diff returns the paths of the settings that differ between s and other.
+// A nil s is treated like empty settings.
+func (s *CustomHostnameSSLSettings) diff(other *CustomHostnameSSLSettings) []string {
+	var a, b CustomHostnameSSLSettings
+	if s != nil {
+		a = *s
+	}
+	if other != nil {
+		b = *other
+	}
+	var diff []string
+	if a.HTTP2 != b.HTTP2 {
+		diff = append(diff, "ssl.settings.http2")
+	}
+	if a.TLS13 != b.TLS13 {
+		diff = append(diff, "ssl.settings.tls_1_3")
+	}
+	if a.MinTLSVersion != b.MinTLSVersion {
+		diff = append(diff, "ssl.settings.min_tls_version")
+	}
+	if !reflect.DeepEqual(a.Ciphers, b.Ciphers) && (len(a.Ciphers) > 0 || len(b.Ciphers) > 0) {
+		diff = append(diff, "ssl.settings.ciphers")
+	}
+	if a.EarlyHints != b.EarlyHints {
+		diff = append(diff, "ssl.settings.early_hints")
+	}
+	return diff
+}
+
+// CustomHostnameSSLValidationRecord describes a record proving control of a
+// custom hostname: a TXT record, a file served over HTTP or, with the email
+// method, the approver addresses the validation email is sent to.
+type CustomHostnameSSLValidationRecord struct {
+	TxtName  string   `json:"txt_name,omitempty"`
+	TxtValue string   `json:"txt_value,omitempty"`
+	HTTPURL  string   `json:"http_url,omitempty"`
+	HTTPBody string   `json:"http_body,omitempty"`
+	Emails   []string `json:"emails,omitempty"`
+}
+
+// CustomHostnameSSLValidationError describes why the certificate of a custom
+// hostname could not be validated yet.
+type CustomHostnameSSLValidationError struct {
+	Message string `json:"message,omitempty"`
 }
 
 // CustomMetadata defines custom metadata for the hostname. This requires logic to be implemented by Cloudflare to act on the data provided.
 type CustomMetadata map[string]interface{}
 
 // CustomHostname represents a custom hostname in a zone.
+//
+// Custom hostnames only exist within a zone, even for SaaS providers managing
+// them for many tenants: the zoneID taken by the custom hostname methods is
+// the provider's SaaS zone, which SaaSZoneID resolves within an account.
 type CustomHostname struct {
 	ID             string            `json:"id,omitempty"`
 	Hostname       string            `json:"hostname,omitempty"`
 	SSL            CustomHostnameSSL `json:"ssl,omitempty"`
 	CustomMetadata CustomMetadata    `json:"custom_metadata,omitempty"`
+	// CustomOriginServer is the hostname traffic to this custom hostname is
+	// sent to instead of the zone's default origin. It has to be a hostname
+	// of the zone; see ValidateCustomOriginServer.
+	CustomOriginServer string `json:"custom_origin_server,omitempty"`
+	// Status, VerificationErrors and CreatedAt are read-only and describe
+	// the hostname itself, as opposed to its certificate.
+	Status             string     `json:"status,omitempty"`
+	VerificationErrors []string   `json:"verification_errors,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+}
+
+// Diff returns the JSON paths of the user-settable fields that differ between
+// ch and other, e.g. "ssl.method" or "custom_metadata.tenant". Read-only fields
+// such as the ID or the SSL status are ignored, so an empty result means no
+// update is needed.
+func (ch CustomHostname) Diff(other CustomHostname) []string {
+	var diff []string
+	if ch.Hostname != other.Hostname {
+		diff = append(diff, "hostname")
+	}
+	if ch.CustomOriginServer != other.CustomOriginServer {
+		diff = append(diff, "custom_origin_server")
+	}
+	if ch.SSL.Method != other.SSL.Method {
+		diff = append(diff, "ssl.method")
+	}
+	if ch.SSL.Type != other.SSL.Type {
+		diff = append(diff, "ssl.type")
+	}
+	diff = append(diff, ch.SSL.Settings.diff(other.SSL.Settings)...)
+	return append(diff, ch.CustomMetadata.diff(other.CustomMetadata)...)
+}
+
+// ErrInvalidCustomOrigin is returned by ValidateCustomOriginServer for an
+// origin which is not a well-formed hostname.
+var ErrInvalidCustomOrigin = errors.New("invalid custom origin server: must be a hostname such as origin.example.com")
+
+// ValidateCustomOriginServer checks that origin is a well-formed hostname
+// before it is used as a CustomOriginServer, returning ErrInvalidCustomOrigin
+// otherwise. If zoneName is not empty, an origin outside of the zone is
+// reported through the API's logger; it is not treated as an error as the
+// API may allow it.
+func (api *API) ValidateCustomOriginServer(origin, zoneName string) error {
+	if !isHostname(origin) {
+		return ErrInvalidCustomOrigin
+	}
+	if zoneName != "" {
+		origin, zoneName = strings.ToLower(origin), strings.ToLower(zoneName)
+		if origin != zoneName && !strings.HasSuffix(origin, "."+zoneName) {
+			api.logger.Printf("custom origin server %s is outside of zone %s and may be rejected\n", origin, zoneName)
+		}
+	}
+	return nil
+}
+
+// OriginLoops reports whether the custom origin server of ch is the custom
+// hostname itself. Requests to the hostname would then be sent back to it
+// with the same Host header, looping through Cloudflare.
+//
+// The API offers no way to test an origin, so this only catches the direct
+// loop, not one through further hostnames.
+func (ch CustomHostname) OriginLoops() bool {
+	if ch.Hostname == "" || ch.CustomOriginServer == "" {
+		return false
+	}
+	normalize := func(s string) string { return strings.TrimSuffix(strings.ToLower(s), ".") }
+	return normalize(ch.Hostname) == normalize(ch.CustomOriginServer)
+}
+
+// warnOriginLoop reports through the API's logger a custom hostname whose
+// custom origin server is the hostname itself. It is not treated as an error
+// as the API does not reject it.
+func (api *API) warnOriginLoop(ch CustomHostname) {
+	if ch.OriginLoops() {
+		api.logger.Printf("custom origin server %s is the custom hostname itself and may cause a request loop\n", ch.CustomOriginServer)
+	}
+}
+
+// isHostname reports whether s is a fully qualified hostname made of LDH
+// labels, without scheme, port or trailing dot.
+func isHostname(s string) bool {
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// defaultCustomMetadataLimit is the size limit the API applies to the custom
+// metadata of a hostname.
+const defaultCustomMetadataLimit = 4096
+
+// ErrMetadataTooLarge is returned when the JSON encoded custom metadata of a
+// hostname exceeds the limit set with UsingCustomMetadataLimit.
+var ErrMetadataTooLarge = errors.New("custom metadata exceeds the size limit")
+
+// validateSize returns ErrMetadataTooLarge if the JSON encoding of m is larger
+// than limit bytes. A limit of zero or less disables the check.
+func (m CustomMetadata) validateSize(limit int) error {
+	if limit <= 0 || len(m) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling custom metadata to JSON")
+	}
+	if len(b) > limit {
+		return ErrMetadataTooLarge
+	}
+	return nil
+}
+
+// diff returns the sorted paths of the keys that were added, removed or
+// changed between m and other. Values are compared by their JSON encoding,
+// so a local int matches the float64 the same value decodes to.
+func (m CustomMetadata) diff(other CustomMetadata) []string {
+	var diff []string
+	for k, v := range m {
+		if ov, ok := other[k]; !ok || !jsonEqual(v, ov) {
+			diff = append(diff, "custom_metadata."+k)
+		}
+	}
+	for k := range other {
+		if _, ok := m[k]; !ok {
+			diff = append(diff, "custom_metadata."+k)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// jsonEqual reports whether a and b have the same JSON encoding. Values that
+// cannot be encoded are compared with reflect.DeepEqual.
+func jsonEqual(a, b interface{}) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return bytes.Equal(ja, jb)
 }
 
 // CustomHostNameResponse represents a response from the Custom Hostnames endpoints.
@@ -41,6 +356,42 @@ type CustomHostnameListResponse struct {
 	ResultInfo `json:"result_info"`
 }
 
+// CustomHostnameBulkResponse represents a response carrying several custom
+// hostnames, one per item of a batched request. A result holding a single
+// object rather than an array is decoded as a one-element Result.
+type CustomHostnameBulkResponse struct {
+	Result []CustomHostname `json:"result"`
+	Response
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *CustomHostnameBulkResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Result json.RawMessage `json:"result"`
+		Response
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Response = raw.Response
+	r.Result = nil
+
+	result := strings.TrimSpace(string(raw.Result))
+	switch {
+	case result == "" || result == "null":
+		return nil
+	case strings.HasPrefix(result, "{"):
+		var ch CustomHostname
+		if err := json.Unmarshal(raw.Result, &ch); err != nil {
+			return err
+		}
+		r.Result = []CustomHostname{ch}
+		return nil
+	default:
+		return json.Unmarshal(raw.Result, &r.Result)
+	}
+}
+
 // Modify SSL configuration for the given custom hostname in the given zone.
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-update-custom-hostname-configuration
@@ -52,14 +403,20 @@ func (api *API) UpdateCustomHostnameSSL(zoneID string, customHostnameID string,
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-delete-a-custom-hostname-and-any-issued-ssl-certificates-
 func (api *API) DeleteCustomHostname(zoneID string, customHostnameID string) error {
+	return api.DeleteCustomHostnameContext(context.TODO(), zoneID, customHostnameID)
+}
+
+// DeleteCustomHostnameContext is like DeleteCustomHostname, but the request
+// is aborted once ctx is done.
+func (api *API) DeleteCustomHostnameContext(ctx context.Context, zoneID string, customHostnameID string) error {
 	uri := "/zones/" + zoneID + "/custom_hostnames/" + customHostnameID
-	res, err := api.makeRequest("DELETE", uri, nil)
+	res, err := api.makeRequestContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 
 	var response *CustomHostnameResponse
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
@@ -69,16 +426,38 @@ func (api *API) DeleteCustomHostname(zoneID string, customHostnameID string) err
 
 // CreateCustomHostname creates a new custom hostname and requests that an SSL certificate be issued for it.
 //
+// Custom metadata larger than the configured limit is rejected with
+// ErrMetadataTooLarge, and an undocumented SSL type with ErrInvalidSSLType,
+// without making a request. When SSL is configured without a type, the type
+// defaults to CustomHostnameSSLTypeDV.
+//
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-create-custom-hostname
 func (api *API) CreateCustomHostname(zoneID string, ch CustomHostname) (*CustomHostnameResponse, error) {
+	return api.CreateCustomHostnameContext(context.TODO(), zoneID, ch)
+}
+
+// CreateCustomHostnameContext is like CreateCustomHostname, but the request
+// is aborted once ctx is done.
+func (api *API) CreateCustomHostnameContext(ctx context.Context, zoneID string, ch CustomHostname) (*CustomHostnameResponse, error) {
+	if err := ch.CustomMetadata.validateSize(api.metadataLimit); err != nil {
+		return nil, err
+	}
+	if err := ch.SSL.validateType(); err != nil {
+		return nil, err
+	}
+	if ch.SSL.Type == "" && !reflect.DeepEqual(ch.SSL, CustomHostnameSSL{}) {
+		ch.SSL.Type = CustomHostnameSSLTypeDV
+	}
+	api.warnOriginLoop(ch)
+
 	uri := "/zones/" + zoneID + "/custom_hostnames"
-	res, err := api.makeRequest("POST", uri, ch)
+	res, err := api.makeRequestContext(ctx, "POST", uri, ch)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 
 	var response *CustomHostnameResponse
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -86,6 +465,188 @@ func (api *API) CreateCustomHostname(zoneID string, ch CustomHostname) (*CustomH
 	return response, nil
 }
 
+// writable returns ch without the read-only fields reported by the API, so a
+// fetched custom hostname can be passed back to UpdateCustomHostname.
+func (ch CustomHostname) writable() CustomHostname {
+	ch.ID = ""
+	ch.Status = ""
+	ch.VerificationErrors = nil
+	ch.CreatedAt = nil
+	ch.SSL = CustomHostnameSSL{
+		Method:   ch.SSL.Method,
+		Type:     ch.SSL.Type,
+		Settings: ch.SSL.Settings,
+	}
+	return ch
+}
+
+// customHostnameClearableFields lists the JSON names of the fields
+// UpdateCustomHostname can clear.
+var customHostnameClearableFields = []string{"custom_origin_server", "custom_metadata"}
+
+// UpdateCustomHostname modifies the given custom hostname. Only the non-empty
+// fields of ch are sent, so fields named in clearFields by their JSON name,
+// e.g. "custom_origin_server" to stop sending traffic to a dedicated origin,
+// are sent as null to remove them explicitly. Read-only fields, such as the
+// ID and statuses, are never sent, so ch may be a fetched custom hostname.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-edit-custom-hostname
+func (api *API) UpdateCustomHostname(zoneID string, customHostnameID string, ch CustomHostname, clearFields ...string) (*CustomHostnameResponse, error) {
+	if err := ch.CustomMetadata.validateSize(api.metadataLimit); err != nil {
+		return nil, err
+	}
+	if err := ch.SSL.validateType(); err != nil {
+		return nil, err
+	}
+	api.warnOriginLoop(ch)
+
+	ch = ch.writable()
+	b, err := json.Marshal(ch)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling params to JSON")
+	}
+	params := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &params); err != nil {
+		return nil, errors.Wrap(err, "error marshalling params to JSON")
+	}
+	// ssl is a struct and so is never omitted by encoding/json.
+	if reflect.DeepEqual(ch.SSL, CustomHostnameSSL{}) {
+		delete(params, "ssl")
+	}
+	for _, field := range clearFields {
+		if !containsString(customHostnameClearableFields, field) {
+			return nil, errors.Errorf("invalid field %q to clear: must be one of %s", field, strings.Join(customHostnameClearableFields, ", "))
+		}
+		params[field] = json.RawMessage("null")
+	}
+
+	uri := "/zones/" + zoneID + "/custom_hostnames/" + customHostnameID
+	res, err := api.makeRequest("PATCH", uri, params)
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var response *CustomHostnameResponse
+	err = api.unmarshal(res, &response)
+	if err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return response, nil
+}
+
+// CreateCustomHostnames creates each of the given custom hostnames in turn.
+// Hostnames which could not be created are reported through a *MultiError
+// keyed by hostname, while the response holds those that were created, so a
+// partially failed batch can be retried for the failed items only.
+func (api *API) CreateCustomHostnames(zoneID string, chs []CustomHostname) (*CustomHostnameBulkResponse, error) {
+	return api.CreateCustomHostnamesContext(context.TODO(), zoneID, chs)
+}
+
+// CreateCustomHostnamesContext is like CreateCustomHostnames, but stops
+// starting new items once ctx is done. The item in flight at that point is
+// allowed to finish, so the response reflects everything that was created;
+// the items that were not attempted are reported in the *MultiError with
+// ctx.Err().
+func (api *API) CreateCustomHostnamesContext(ctx context.Context, zoneID string, chs []CustomHostname) (*CustomHostnameBulkResponse, error) {
+	response := &CustomHostnameBulkResponse{}
+	var errs MultiError
+	for i, ch := range chs {
+		if err := ctx.Err(); err != nil {
+			for _, skipped := range chs[i:] {
+				errs.Add(skipped.Hostname, err)
+			}
+			break
+		}
+		// The request is not made with ctx, so cancelling never leaves a
+		// hostname for which it is unknown whether it was created.
+		r, err := api.CreateCustomHostname(zoneID, ch)
+		if err != nil {
+			errs.Add(ch.Hostname, err)
+			continue
+		}
+		response.Result = append(response.Result, r.Result)
+		response.Messages = append(response.Messages, r.Messages...)
+	}
+	response.Success = len(errs.Errors) == 0
+	return response, errs.ErrorOrNil()
+}
+
+// DeleteCustomHostnames deletes each of the given custom hostnames in turn
+// and returns the IDs of those which were deleted. Hostnames which could not
+// be deleted are reported through a *MultiError keyed by ID.
+func (api *API) DeleteCustomHostnames(zoneID string, customHostnameIDs []string) ([]string, error) {
+	return api.DeleteCustomHostnamesContext(context.TODO(), zoneID, customHostnameIDs)
+}
+
+// DeleteCustomHostnamesContext is like DeleteCustomHostnames, but stops
+// starting new items once ctx is done. The item in flight at that point is
+// allowed to finish, so the returned IDs reflect everything that was
+// deleted; the items that were not attempted are reported in the
+// *MultiError with ctx.Err().
+func (api *API) DeleteCustomHostnamesContext(ctx context.Context, zoneID string, customHostnameIDs []string) ([]string, error) {
+	var deleted []string
+	var errs MultiError
+	for i, id := range customHostnameIDs {
+		if err := ctx.Err(); err != nil {
+			for _, skipped := range customHostnameIDs[i:] {
+				errs.Add(skipped, err)
+			}
+			break
+		}
+		if err := api.DeleteCustomHostname(zoneID, id); err != nil {
+			errs.Add(id, err)
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+	return deleted, errs.ErrorOrNil()
+}
+
+// CustomHostnameListOptions contains the filtering and ordering options
+// used when listing custom hostnames.
+type CustomHostnameListOptions struct {
+	// Hostname filters the results to hostnames matching the given value.
+	Hostname string
+	// Order is the field results are sorted by: "ssl" or "ssl_status".
+	Order string
+	// Direction is the sort direction: "asc" or "desc".
+	Direction string
+}
+
+// validate checks the options against the values accepted by the API.
+func (o CustomHostnameListOptions) validate() error {
+	switch o.Order {
+	case "", "ssl", "ssl_status":
+	default:
+		return errors.Errorf("invalid order %q: must be one of ssl, ssl_status", o.Order)
+	}
+	switch o.Direction {
+	case "", "asc", "desc":
+	default:
+		return errors.Errorf("invalid direction %q: must be one of asc, desc", o.Direction)
+	}
+	return nil
+}
+
+// encode encodes the non-empty options into URL query values.
+func (o CustomHostnameListOptions) encode() url.Values {
+	v := url.Values{}
+	if o.Hostname != "" {
+		v.Set("hostname", o.Hostname)
+	}
+	if o.Order != "" {
+		v.Set("order", o.Order)
+	}
+	if o.Direction != "" {
+		v.Set("direction", o.Direction)
+	}
+	return v
+}
+
+// customHostnamesPerPage is the page size used when listing custom hostnames.
+const customHostnamesPerPage = 50
+
 // CustomHostnames fetches custom hostnames for the given zone,
 // by applying filter.Hostname if not empty and scoping the result to page'th 50 items.
 //
@@ -93,57 +654,286 @@ func (api *API) CreateCustomHostname(zoneID string, ch CustomHostname) (*CustomH
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-list-custom-hostnames
 func (api *API) CustomHostnames(zoneID string, page int, filter CustomHostname) ([]CustomHostname, ResultInfo, error) {
-	v := url.Values{}
-	v.Set("per_page", "50")
-	v.Set("page", strconv.Itoa(page))
-	if filter.Hostname != "" {
-		v.Set("hostname", filter.Hostname)
+	return api.FilterCustomHostnames(zoneID, page, CustomHostnameListOptions{Hostname: filter.Hostname})
+}
+
+// FilterCustomHostnames fetches the page'th 50 custom hostnames for the given
+// zone, filtered and ordered according to opts. Invalid options are rejected
+// before a request is made.
+//
+// Listed hostnames always carry their VerificationErrors and SSL
+// ValidationErrors; the API does not require a parameter to include them.
+//
+// The returned ResultInfo can be used to implement pagination. With
+// UsingETagCache, an unchanged page is returned from the cache along with
+// ErrNotModified.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-list-custom-hostnames
+func (api *API) FilterCustomHostnames(zoneID string, page int, opts CustomHostnameListOptions) ([]CustomHostname, ResultInfo, error) {
+	return api.filterCustomHostnames(context.TODO(), zoneID, page, opts)
+}
+
+func (api *API) filterCustomHostnames(ctx context.Context, zoneID string, page int, opts CustomHostnameListOptions) ([]CustomHostname, ResultInfo, error) {
+	if err := opts.validate(); err != nil {
+		return []CustomHostname{}, ResultInfo{}, err
 	}
+
+	v := opts.encode()
+	v.Set("per_page", strconv.Itoa(customHostnamesPerPage))
+	v.Set("page", strconv.Itoa(page))
 	query := "?" + v.Encode()
 
 	uri := "/zones/" + zoneID + "/custom_hostnames" + query
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return []CustomHostname{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
+	res, reqErr := api.makeConditionalRequest(ctx, uri)
+	if reqErr != nil && reqErr != ErrNotModified {
+		return []CustomHostname{}, ResultInfo{}, errors.Wrap(reqErr, errMakeRequestError)
 	}
 	var customHostnameListResponse CustomHostnameListResponse
-	err = json.Unmarshal(res, &customHostnameListResponse)
+	err := api.unmarshal(res, &customHostnameListResponse)
+	if err != nil {
+		return []CustomHostname{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return customHostnameListResponse.Result, customHostnameListResponse.ResultInfo, reqErr
+}
+
+// ForEachCustomHostname calls fn for every custom hostname of the given zone,
+// fetching them one page at a time so that only a single page is held in
+// memory. Iteration stops at the first error returned by fn, which is
+// returned as is.
+func (api *API) ForEachCustomHostname(zoneID string, fn func(CustomHostname) error) error {
+	return api.ForEachCustomHostnameLimit(zoneID, 0, fn)
+}
+
+// ForEachCustomHostnameLimit is like ForEachCustomHostname but stops after fn
+// has been called for limit custom hostnames, without fetching further pages.
+// A limit of zero or less means no limit.
+func (api *API) ForEachCustomHostnameLimit(zoneID string, limit int, fn func(CustomHostname) error) error {
+	var seen int
+	for page := 1; ; page++ {
+		customHostnames, resultInfo, err := api.FilterCustomHostnames(zoneID, page, CustomHostnameListOptions{})
+		if err != nil && err != ErrNotModified {
+			return err
+		}
+		for _, ch := range customHostnames {
+			if err := fn(ch); err != nil {
+				return err
+			}
+			seen++
+			if limit > 0 && seen >= limit {
+				return nil
+			}
+		}
+		if resultInfo.Page >= resultInfo.TotalPages {
+			return nil
+		}
+	}
+}
+
+// ListAllCustomHostnames returns all custom hostnames of the given zone,
+// fetching every page.
+func (api *API) ListAllCustomHostnames(zoneID string) ([]CustomHostname, error) {
+	return api.ListCustomHostnamesLimit(zoneID, 0)
+}
+
+// ListCustomHostnamesLimit returns at most limit custom hostnames of the given
+// zone, fetching only as many pages as needed. A limit of zero or less means
+// no limit.
+func (api *API) ListCustomHostnamesLimit(zoneID string, limit int) ([]CustomHostname, error) {
+	var customHostnames []CustomHostname
+	err := api.ForEachCustomHostnameLimit(zoneID, limit, func(ch CustomHostname) error {
+		customHostnames = append(customHostnames, ch)
+		return nil
+	})
+	if err != nil {
+		return []CustomHostname{}, err
+	}
+	return customHostnames, nil
+}
+
+// CustomHostnameState is the status of a custom hostname and of its
+// certificate.
+type CustomHostnameState struct {
+	Status    string
+	SSLStatus string
+}
+
+// Active reports whether both the hostname and its certificate are active.
+func (s CustomHostnameState) Active() bool {
+	return s.Status == CustomHostnameStatusActive && s.SSLStatus == CustomHostnameSSLStatusActive
+}
+
+// CustomHostnameStates returns the state of every custom hostname of the
+// given zone keyed by hostname ID. It lists the hostnames rather than
+// fetching them one by one, so a batch of hostnames can be polled cheaply.
+func (api *API) CustomHostnameStates(zoneID string) (map[string]CustomHostnameState, error) {
+	states := make(map[string]CustomHostnameState)
+	err := api.ForEachCustomHostname(zoneID, func(ch CustomHostname) error {
+		states[ch.ID] = CustomHostnameState{Status: ch.Status, SSLStatus: ch.SSL.Status}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+// CustomHostnameStatusCounts returns the number of custom hostnames of the
+// given zone keyed by certificate status, e.g. "active" or
+// "pending_validation". Hostnames without a certificate are counted under
+// the empty string.
+//
+// The API has no aggregated or count-only endpoint, so every hostname is
+// listed through ForEachCustomHostname, customHostnamesPerPage (50) at a
+// time.
+func (api *API) CustomHostnameStatusCounts(zoneID string) (map[string]int, error) {
+	counts := make(map[string]int)
+	err := api.ForEachCustomHostname(zoneID, func(ch CustomHostname) error {
+		counts[ch.SSL.Status]++
+		return nil
+	})
 	if err != nil {
-		return []CustomHostname{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
+		return nil, err
 	}
+	return counts, nil
+}
 
-	return customHostnameListResponse.Result, customHostnameListResponse.ResultInfo, nil
+// CustomHostnamesCreatedAfter returns the custom hostnames of the given zone
+// created after the given time, e.g. the time of a previous sync.
+//
+// The API neither filters on creation nor exposes a modification time, so all
+// hostnames are fetched and filtered on CreatedAt client-side. Hostnames
+// without a creation time are skipped.
+func (api *API) CustomHostnamesCreatedAfter(zoneID string, since time.Time) ([]CustomHostname, error) {
+	var customHostnames []CustomHostname
+	err := api.ForEachCustomHostname(zoneID, func(ch CustomHostname) error {
+		if ch.CreatedAt != nil && ch.CreatedAt.After(since) {
+			customHostnames = append(customHostnames, ch)
+		}
+		return nil
+	})
+	if err != nil {
+		return []CustomHostname{}, err
+	}
+	return customHostnames, nil
 }
 
 // CustomHostname inspects the given custom hostname in the given zone.
 //
+// With UsingETagCache, an unchanged hostname is returned from the cache along
+// with ErrNotModified.
+//
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-custom-hostname-configuration-details
 func (api *API) CustomHostname(zoneID string, customHostnameID string) (CustomHostname, error) {
+	return api.CustomHostnameContext(context.TODO(), zoneID, customHostnameID)
+}
+
+// CustomHostnameContext is like CustomHostname, but the request is aborted
+// once ctx is done.
+func (api *API) CustomHostnameContext(ctx context.Context, zoneID string, customHostnameID string) (CustomHostname, error) {
 	uri := "/zones/" + zoneID + "/custom_hostnames/" + customHostnameID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return CustomHostname{}, errors.Wrap(err, errMakeRequestError)
+	res, reqErr := api.makeConditionalRequest(ctx, uri)
+	if reqErr != nil && reqErr != ErrNotModified {
+		return CustomHostname{}, errors.Wrap(reqErr, errMakeRequestError)
 	}
 
 	var response CustomHostnameResponse
-	err = json.Unmarshal(res, &response)
+	err := api.unmarshal(res, &response)
 	if err != nil {
 		return CustomHostname{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return response.Result, nil
+	return response.Result, reqErr
+}
+
+// CustomHostnameApproverEmails returns the addresses the validation email of
+// a custom hostname using the email validation method is sent to, in the
+// order reported by the API and without duplicates.
+func (api *API) CustomHostnameApproverEmails(zoneID string, customHostnameID string) ([]string, error) {
+	ch, err := api.CustomHostname(zoneID, customHostnameID)
+	if err != nil && err != ErrNotModified {
+		return nil, err
+	}
+	var emails []string
+	seen := make(map[string]bool)
+	for _, record := range ch.SSL.ValidationRecords {
+		for _, email := range record.Emails {
+			if !seen[email] {
+				seen[email] = true
+				emails = append(emails, email)
+			}
+		}
+	}
+	return emails, nil
+}
+
+// SaaSZoneID returns the ID of the SaaS zone named zoneName in the given
+// account, to be passed as the zoneID of the custom hostname methods. The
+// zone must belong to the account, so provisioning code working on behalf
+// of an account cannot act on an identically named zone elsewhere.
+//
+// API reference: https://api.cloudflare.com/#zone-list-zones
+func (api *API) SaaSZoneID(accountID, zoneName string) (string, error) {
+	v := url.Values{}
+	v.Set("name", zoneName)
+	v.Set("account.id", accountID)
+	res, err := api.makeRequest("GET", "/zones?"+v.Encode(), nil)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZonesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	for _, zone := range r.Result {
+		if zone.Name == zoneName && zone.Account.ID == accountID {
+			return zone.ID, nil
+		}
+	}
+	return "", errors.Errorf("SaaS zone %s could not be found in account %s", zoneName, accountID)
 }
 
 // CustomHostnameIDByName retrieves the ID for the given hostname in the given zone.
 func (api *API) CustomHostnameIDByName(zoneID string, hostname string) (string, error) {
-	customHostnames, _, err := api.CustomHostnames(zoneID, 1, CustomHostname{Hostname: hostname})
+	return api.CustomHostnameIDByNameContext(context.TODO(), zoneID, hostname)
+}
+
+// CustomHostnameIDByNameContext is like CustomHostnameIDByName but pages
+// through the filtered results under the given context until an exact match
+// is found or all pages have been inspected.
+func (api *API) CustomHostnameIDByNameContext(ctx context.Context, zoneID string, hostname string) (string, error) {
+	exists, id, err := api.customHostnameExists(ctx, zoneID, hostname)
 	if err != nil {
-		return "", errors.Wrap(err, "CustomHostnames command failed")
+		return "", err
 	}
-	for _, ch := range customHostnames {
-		if ch.Hostname == hostname {
-			return ch.ID, nil
+	if !exists {
+		return "", errors.New("CustomHostname could not be found")
+	}
+	return id, nil
+}
+
+// CustomHostnameExists reports whether a custom hostname exactly matching
+// hostname exists in the given zone and, if so, returns its ID. It relies on
+// the list endpoint's hostname filter, so an absent hostname is not an
+// error.
+func (api *API) CustomHostnameExists(zoneID, hostname string) (bool, string, error) {
+	return api.customHostnameExists(context.TODO(), zoneID, hostname)
+}
+
+func (api *API) customHostnameExists(ctx context.Context, zoneID, hostname string) (bool, string, error) {
+	opts := CustomHostnameListOptions{Hostname: hostname}
+	for page := 1; ; page++ {
+		customHostnames, resultInfo, err := api.filterCustomHostnames(ctx, zoneID, page, opts)
+		if err != nil && err != ErrNotModified {
+			return false, "", errors.Wrap(err, "CustomHostnames command failed")
+		}
+		for _, ch := range customHostnames {
+			if ch.Hostname == hostname {
+				return true, ch.ID, nil
+			}
+		}
+		if resultInfo.Page >= resultInfo.TotalPages {
+			return false, "", nil
 		}
 	}
-	return "", errors.New("CustomHostname could not be found")
 }
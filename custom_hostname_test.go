@@ -1,9 +1,18 @@
 package cloudflare
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"log"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -176,3 +185,1446 @@ func TestCustomHostname_CustomHostname(t *testing.T) {
 		assert.Equal(t, want, customHostname)
 	}
 }
+
+func TestCustomHostname_FilterCustomHostnames(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "ssl_status", r.URL.Query().Get("order"))
+		assert.Equal(t, "desc", r.URL.Query().Get("direction"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+"success": true,
+"result": [
+    {
+      "id": "custom_host_1",
+      "hostname": "custom.host.one",
+      "ssl": {
+        "type": "dv",
+        "method": "http",
+        "status": "active"
+      }
+    }
+],
+"result_info": {
+    "page": 2,
+    "per_page": 50,
+    "count": 1,
+    "total_count": 51
+}
+}`)
+	})
+
+	customHostnames, resultInfo, err := client.FilterCustomHostnames("foo", 2, CustomHostnameListOptions{Order: "ssl_status", Direction: "desc"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "custom_host_1", customHostnames[0].ID)
+		assert.Equal(t, 51, resultInfo.Total)
+	}
+}
+
+func TestCustomHostname_FilterCustomHostnamesInvalidOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should have been made for invalid options")
+	})
+
+	_, _, err := client.FilterCustomHostnames("foo", 1, CustomHostnameListOptions{Order: "hostname"})
+	assert.EqualError(t, err, `invalid order "hostname": must be one of ssl, ssl_status`)
+
+	_, _, err = client.FilterCustomHostnames("foo", 1, CustomHostnameListOptions{Direction: "up"})
+	assert.EqualError(t, err, `invalid direction "up": must be one of asc, desc`)
+}
+
+func TestCustomHostname_Diff(t *testing.T) {
+	desired := CustomHostname{
+		Hostname:       "app.example.com",
+		SSL:            CustomHostnameSSL{Method: "http", Type: "dv"},
+		CustomMetadata: CustomMetadata{"tenant": "acme", "plan": "pro"},
+	}
+
+	remote := desired
+	remote.ID = "0d89c70d-ad9f-4843-b99f-6cc0252067e9"
+	remote.SSL.Status = "active"
+	remote.SSL.CnameTarget = "dcv.digicert.com"
+	remote.CustomMetadata = CustomMetadata{"tenant": "acme", "plan": "pro"}
+	assert.Empty(t, desired.Diff(remote), "read-only fields should be ignored")
+
+	remote.SSL.Method = "cname"
+	assert.Equal(t, []string{"ssl.method"}, desired.Diff(remote))
+
+	remote.SSL.Type = "ev"
+	assert.Equal(t, []string{"ssl.method", "ssl.type"}, desired.Diff(remote))
+}
+
+func TestCustomHostname_DiffCustomMetadata(t *testing.T) {
+	a := CustomHostname{CustomMetadata: CustomMetadata{
+		"tenant": "acme",
+		"plan":   "pro",
+		"nested": map[string]interface{}{"a": "b"},
+	}}
+	b := CustomHostname{CustomMetadata: CustomMetadata{
+		"tenant": "globex",
+		"nested": map[string]interface{}{"a": "b"},
+		"region": "eu",
+	}}
+
+	assert.Equal(t, []string{"custom_metadata.plan", "custom_metadata.region", "custom_metadata.tenant"}, a.Diff(b))
+	assert.Equal(t, []string{"custom_metadata.plan", "custom_metadata.region", "custom_metadata.tenant"}, b.Diff(a))
+	assert.Empty(t, a.Diff(a))
+	assert.Empty(t, CustomHostname{}.Diff(CustomHostname{CustomMetadata: CustomMetadata{}}))
+}
+
+func TestCustomHostname_DiffCustomMetadataRoundTrip(t *testing.T) {
+	desired := CustomHostname{CustomMetadata: CustomMetadata{
+		"max_uploads": 10,
+		"limits":      map[string]interface{}{"rps": int64(250), "burst": 1.5},
+		"tags":        []string{"a", "b"},
+	}}
+
+	b, err := json.Marshal(desired)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var remote CustomHostname
+	if assert.NoError(t, json.Unmarshal(b, &remote)) {
+		assert.Empty(t, desired.Diff(remote))
+	}
+
+	remote.CustomMetadata["max_uploads"] = 11.0
+	assert.Equal(t, []string{"custom_metadata.max_uploads"}, desired.Diff(remote))
+}
+
+func TestCustomHostname_FilterCustomHostnamesVerificationErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+"success": true,
+"result": [
+    {
+      "id": "custom_host_1",
+      "hostname": "custom.host.one",
+      "ssl": {
+        "type": "dv",
+        "method": "http",
+        "status": "pending_validation",
+        "validation_errors": [
+          {"message": "SERVFAIL looking up CAA for app.example.com"}
+        ]
+      },
+      "status": "pending",
+      "verification_errors": [
+        "None of the A or AAAA records are owned by this account and the pre-generated ownership verification token was not found."
+      ]
+    },
+    {
+      "id": "custom_host_2",
+      "hostname": "custom.host.two",
+      "ssl": {
+        "type": "dv",
+        "method": "http",
+        "status": "active"
+      },
+      "status": "active"
+    }
+],
+"result_info": {
+    "page": 1,
+    "per_page": 50,
+    "count": 2,
+    "total_count": 2
+}
+}`)
+	})
+
+	customHostnames, _, err := client.FilterCustomHostnames("foo", 1, CustomHostnameListOptions{})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "pending", customHostnames[0].Status)
+		assert.Equal(t, []string{
+			"None of the A or AAAA records are owned by this account and the pre-generated ownership verification token was not found.",
+		}, customHostnames[0].VerificationErrors)
+		assert.Equal(t, []CustomHostnameSSLValidationError{
+			{Message: "SERVFAIL looking up CAA for app.example.com"},
+		}, customHostnames[0].SSL.ValidationErrors)
+
+		assert.Equal(t, "active", customHostnames[1].Status)
+		assert.Empty(t, customHostnames[1].VerificationErrors)
+		assert.Empty(t, customHostnames[1].SSL.ValidationErrors)
+	}
+}
+
+func TestCustomHostname_CustomHostnameIDByNameSecondPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "app.example.com", r.URL.Query().Get("hostname"))
+
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+      "hostname": "staging.app.example.com"
+    }
+  ],
+  "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+}`)
+		case "2":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "bd1f4b5a-4b33-42f3-a2ac-1ba2c3e4b7d5",
+      "hostname": "app.example.com"
+    }
+  ],
+  "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	id, err := client.CustomHostnameIDByName("foo", "app.example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "bd1f4b5a-4b33-42f3-a2ac-1ba2c3e4b7d5", id)
+	}
+}
+
+func TestCustomHostname_CustomHostnameExists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "app.example.com", r.URL.Query().Get("hostname"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "bd1f4b5a-4b33-42f3-a2ac-1ba2c3e4b7d5",
+      "hostname": "app.example.com"
+    }
+  ],
+  "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+}`)
+	})
+
+	exists, id, err := client.CustomHostnameExists("foo", "app.example.com")
+	if assert.NoError(t, err) {
+		assert.True(t, exists)
+		assert.Equal(t, "bd1f4b5a-4b33-42f3-a2ac-1ba2c3e4b7d5", id)
+	}
+}
+
+func TestCustomHostname_CustomHostnameExistsAbsent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+      "hostname": "staging.app.example.com"
+    }
+  ],
+  "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+}`)
+	})
+
+	exists, id, err := client.CustomHostnameExists("foo", "app.example.com")
+	if assert.NoError(t, err) {
+		assert.False(t, exists)
+		assert.Equal(t, "", id)
+	}
+}
+
+func TestCustomHostname_FilterCustomHostnamesResultInfo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+"success": true,
+"errors": [],
+"messages": [],
+"result": [],
+"result_info": {
+    "page": 3,
+    "per_page": 50,
+    "count": 20,
+    "total_count": 120,
+    "total_pages": 3,
+    "cursor": "c3",
+    "cursors": {
+      "before": "c2",
+      "after": "c4"
+    }
+}
+}`)
+	})
+
+	_, resultInfo, err := client.FilterCustomHostnames("foo", 3, CustomHostnameListOptions{})
+
+	want := ResultInfo{
+		Page:       3,
+		PerPage:    50,
+		TotalPages: 3,
+		Count:      20,
+		Total:      120,
+		Cursor:     "c3",
+		Cursors:    ResultInfoCursors{Before: "c2", After: "c4"},
+	}
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, resultInfo)
+	}
+}
+
+func TestCustomHostname_ValidateCustomOriginServer(t *testing.T) {
+	var buf bytes.Buffer
+	setup(UsingLogger(log.New(&buf, "", 0)))
+	defer teardown()
+
+	assert.NoError(t, client.ValidateCustomOriginServer("origin.example.com", "example.com"))
+	assert.NoError(t, client.ValidateCustomOriginServer("Origin.Example.com", "example.com"))
+	assert.Empty(t, buf.String())
+
+	assert.NoError(t, client.ValidateCustomOriginServer("origin.example.net", "example.com"))
+	assert.Equal(t, "custom origin server origin.example.net is outside of zone example.com and may be rejected\n", buf.String())
+}
+
+func TestCustomHostname_OriginLoops(t *testing.T) {
+	assert.True(t, CustomHostname{Hostname: "app.example.com", CustomOriginServer: "app.example.com"}.OriginLoops())
+	assert.True(t, CustomHostname{Hostname: "App.Example.com", CustomOriginServer: "app.example.com."}.OriginLoops())
+	assert.False(t, CustomHostname{Hostname: "app.example.com", CustomOriginServer: "origin.example.com"}.OriginLoops())
+	assert.False(t, CustomHostname{CustomOriginServer: "app.example.com"}.OriginLoops())
+}
+
+func TestCustomHostname_CreateCustomHostnameOriginLoopWarning(t *testing.T) {
+	var buf bytes.Buffer
+	setup(UsingLogger(log.New(&buf, "", 0)))
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		requests++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com", "custom_origin_server": "app.example.com"}}`)
+	})
+
+	_, err := client.CreateCustomHostname("foo", CustomHostname{Hostname: "app.example.com", CustomOriginServer: "app.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "custom origin server app.example.com is the custom hostname itself and may cause a request loop\n", buf.String())
+
+	buf.Reset()
+	_, err = client.CreateCustomHostname("foo", CustomHostname{Hostname: "app.example.com", CustomOriginServer: "origin.example.com"})
+	assert.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestCustomHostname_UpdateCustomHostnameOriginLoopWarning(t *testing.T) {
+	var buf bytes.Buffer
+	setup(UsingLogger(log.New(&buf, "", 0)))
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com"}}`)
+	})
+
+	_, err := client.UpdateCustomHostname("foo", "bar", CustomHostname{Hostname: "app.example.com", CustomOriginServer: "APP.example.com"})
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "may cause a request loop")
+}
+
+func TestCustomHostname_ValidateCustomOriginServerMalformed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, origin := range []string{"", "localhost", "https://origin.example.com", "origin.example.com:8443", "-origin.example.com", "origin..example.com"} {
+		assert.Equal(t, ErrInvalidCustomOrigin, client.ValidateCustomOriginServer(origin, ""), origin)
+	}
+}
+
+func handleCustomHostnamePages(t *testing.T, requests *int) {
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		*requests++
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"id": "custom_host_%[1]s_a", "hostname": "a.page%[1]s.example.com"},
+    {"id": "custom_host_%[1]s_b", "hostname": "b.page%[1]s.example.com"}
+  ],
+  "result_info": {"page": %[1]s, "per_page": 2, "count": 2, "total_count": 6, "total_pages": 3}
+}`, page)
+	})
+}
+
+func TestCustomHostname_ForEachCustomHostname(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	handleCustomHostnamePages(t, &requests)
+
+	var ids []string
+	err := client.ForEachCustomHostname("foo", func(ch CustomHostname) error {
+		ids = append(ids, ch.ID)
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{
+			"custom_host_1_a", "custom_host_1_b",
+			"custom_host_2_a", "custom_host_2_b",
+			"custom_host_3_a", "custom_host_3_b",
+		}, ids)
+		assert.Equal(t, 3, requests)
+	}
+}
+
+func TestCustomHostname_ForEachCustomHostnameStopsOnError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	handleCustomHostnamePages(t, &requests)
+
+	errStop := errors.New("stop")
+	var seen int
+	err := client.ForEachCustomHostname("foo", func(ch CustomHostname) error {
+		seen++
+		if ch.ID == "custom_host_2_a" {
+			return errStop
+		}
+		return nil
+	})
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, 3, seen)
+	assert.Equal(t, 2, requests)
+}
+
+func TestCustomHostname_ForEachCustomHostnameLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	handleCustomHostnamePages(t, &requests)
+
+	var ids []string
+	err := client.ForEachCustomHostnameLimit("foo", 3, func(ch CustomHostname) error {
+		ids = append(ids, ch.ID)
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"custom_host_1_a", "custom_host_1_b", "custom_host_2_a"}, ids)
+		assert.Equal(t, 2, requests)
+	}
+}
+
+func TestCustomHostname_ListCustomHostnamesLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	handleCustomHostnamePages(t, &requests)
+
+	customHostnames, err := client.ListCustomHostnamesLimit("foo", 1)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(customHostnames)) {
+			assert.Equal(t, "custom_host_1_a", customHostnames[0].ID)
+		}
+		assert.Equal(t, 1, requests)
+	}
+}
+
+func TestCustomHostname_ListCustomHostnamesLimitAboveTotal(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	handleCustomHostnamePages(t, &requests)
+
+	customHostnames, err := client.ListCustomHostnamesLimit("foo", 10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 6, len(customHostnames))
+		assert.Equal(t, 3, requests)
+	}
+}
+
+func TestCustomHostname_CustomHostnameStatusCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"id": "custom_host_1", "hostname": "a.example.com", "ssl": {"status": "active"}},
+    {"id": "custom_host_2", "hostname": "b.example.com", "ssl": {"status": "pending_validation"}},
+    {"id": "custom_host_3", "hostname": "c.example.com", "ssl": {"status": "active"}}
+  ],
+  "result_info": {"page": 1, "per_page": 3, "count": 3, "total_count": 5, "total_pages": 2}
+}`)
+		case "2":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"id": "custom_host_4", "hostname": "d.example.com", "ssl": {"status": "active"}},
+    {"id": "custom_host_5", "hostname": "e.example.com"}
+  ],
+  "result_info": {"page": 2, "per_page": 3, "count": 2, "total_count": 5, "total_pages": 2}
+}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	counts, err := client.CustomHostnameStatusCounts("foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int{"active": 3, "pending_validation": 1, "": 1}, counts)
+	}
+}
+
+func TestCustomHostname_CreateCustomHostnameDefaultsSSLType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"hostname": "app.example.com", "ssl": {"method": "http", "type": "dv"}}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com", "ssl": {"method": "http", "type": "dv"}}}`)
+	})
+
+	response, err := client.CreateCustomHostname("foo", CustomHostname{Hostname: "app.example.com", SSL: CustomHostnameSSL{Method: "http"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, CustomHostnameSSLTypeDV, response.Result.SSL.Type)
+	}
+}
+
+func TestCustomHostname_CreateCustomHostnameWithoutSSLLeavesType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.NotContains(t, string(b), `"type"`)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com"}}`)
+	})
+
+	_, err := client.CreateCustomHostname("foo", CustomHostname{Hostname: "app.example.com"})
+	assert.NoError(t, err)
+}
+
+func TestCustomHostname_InvalidSSLType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("custom_hostnames must not be called with an invalid SSL type")
+	})
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("custom_hostnames must not be called with an invalid SSL type")
+	})
+
+	ch := CustomHostname{Hostname: "app.example.com", SSL: CustomHostnameSSL{Method: "http", Type: "ev"}}
+	_, err := client.CreateCustomHostname("foo", ch)
+	assert.Equal(t, ErrInvalidSSLType, err)
+	_, err = client.UpdateCustomHostname("foo", "bar", ch)
+	assert.Equal(t, ErrInvalidSSLType, err)
+}
+
+func TestCustomHostname_CreateCustomHostnameMetadataTooLarge(t *testing.T) {
+	setup(UsingCustomMetadataLimit(64))
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("custom_hostnames must not be called with oversized metadata")
+	})
+
+	_, err := client.CreateCustomHostname("foo", CustomHostname{
+		Hostname:       "app.example.com",
+		CustomMetadata: CustomMetadata{"notes": strings.Repeat("x", 64)},
+	})
+	assert.Equal(t, ErrMetadataTooLarge, err)
+}
+
+func TestCustomHostname_CustomHostnameNotModified(t *testing.T) {
+	setup(UsingETagCache(true))
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		requests++
+		if requests > 1 {
+			assert.Equal(t, `"d1b9e5c7"`, r.Header.Get("If-None-Match"))
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("ETag", `"d1b9e5c7"`)
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {"id": "bar", "hostname": "app.example.com", "ssl": {"status": "active"}}
+}`)
+	})
+
+	first, err := client.CustomHostname("foo", "bar")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "app.example.com", first.Hostname)
+	}
+
+	second, err := client.CustomHostname("foo", "bar")
+	assert.Equal(t, ErrNotModified, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 2, requests)
+}
+
+func TestCustomHostname_CustomHostnameNotModifiedWithoutCache(t *testing.T) {
+	setup(UsingETagCache(true))
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/bar", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	_, err := client.CustomHostname("foo", "bar")
+	assert.False(t, errors.Is(err, ErrNotModified), "unexpected ErrNotModified")
+	var reqErr *RequestError
+	if assert.True(t, errors.As(err, &reqErr), "expected a *RequestError, got %v", err) {
+		assert.Equal(t, http.StatusNotModified, reqErr.StatusCode)
+	}
+}
+
+func TestCustomHostname_FilterCustomHostnamesNotModified(t *testing.T) {
+	setup(UsingETagCache(true))
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `W/"list-1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("ETag", `W/"list-1"`)
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [{"id": "bar", "hostname": "app.example.com"}],
+  "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+}`)
+	})
+
+	_, _, err := client.FilterCustomHostnames("foo", 1, CustomHostnameListOptions{})
+	assert.NoError(t, err)
+
+	customHostnames, resultInfo, err := client.FilterCustomHostnames("foo", 1, CustomHostnameListOptions{})
+	assert.Equal(t, ErrNotModified, err)
+	assert.Equal(t, "bar", customHostnames[0].ID)
+	assert.Equal(t, 1, resultInfo.Total)
+	assert.Equal(t, 2, requests)
+
+	id, err := client.CustomHostnameIDByName("foo", "app.example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "bar", id)
+	}
+}
+
+func TestCustomHostname_SSLSettingsRoundTrip(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+  "hostname": "app.example.com",
+  "ssl": {
+    "method": "http",
+    "type": "dv",
+    "settings": {
+      "http2": "on",
+      "tls_1_3": "on",
+      "min_tls_version": "1.2",
+      "ciphers": ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"],
+      "early_hints": "on"
+    }
+  }
+}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+    "hostname": "app.example.com",
+    "ssl": {
+      "status": "pending_validation",
+      "method": "http",
+      "type": "dv",
+      "settings": {
+        "http2": "on",
+        "tls_1_3": "on",
+        "min_tls_version": "1.2",
+        "ciphers": ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"],
+        "early_hints": "on"
+      }
+    }
+  }
+}`)
+	})
+
+	settings := &CustomHostnameSSLSettings{
+		HTTP2:         "on",
+		TLS13:         "on",
+		MinTLSVersion: "1.2",
+		Ciphers:       []string{"ECDHE-RSA-AES128-GCM-SHA256", "AES128-SHA"},
+		EarlyHints:    "on",
+	}
+	desired := CustomHostname{
+		Hostname: "app.example.com",
+		SSL:      CustomHostnameSSL{Method: "http", Type: "dv", Settings: settings},
+	}
+	resp, err := client.CreateCustomHostname("foo", desired)
+	if assert.NoError(t, err) {
+		assert.Equal(t, settings, resp.Result.SSL.Settings)
+		assert.Empty(t, desired.Diff(resp.Result))
+	}
+
+	remote := resp.Result
+	remote.SSL.Settings = &CustomHostnameSSLSettings{EarlyHints: "off"}
+	assert.Equal(t, []string{
+		"ssl.settings.http2",
+		"ssl.settings.tls_1_3",
+		"ssl.settings.min_tls_version",
+		"ssl.settings.ciphers",
+		"ssl.settings.early_hints",
+	}, desired.Diff(remote))
+}
+
+func TestCustomHostname_SSLSettingsOmitEmpty(t *testing.T) {
+	b, err := json.Marshal(CustomHostnameSSL{Method: "http"})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"method": "http"}`, string(b))
+	}
+
+	b, err = json.Marshal(CustomHostnameSSL{Settings: &CustomHostnameSSLSettings{EarlyHints: "on"}})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"settings": {"early_hints": "on"}}`, string(b))
+	}
+}
+
+func TestCustomHostname_StatusConstants(t *testing.T) {
+	sslStatuses := map[string]string{
+		CustomHostnameSSLStatusInitializing:         "initializing",
+		CustomHostnameSSLStatusPendingValidation:    "pending_validation",
+		CustomHostnameSSLStatusDeleted:              "deleted",
+		CustomHostnameSSLStatusPendingIssuance:      "pending_issuance",
+		CustomHostnameSSLStatusPendingDeployment:    "pending_deployment",
+		CustomHostnameSSLStatusPendingDeletion:      "pending_deletion",
+		CustomHostnameSSLStatusPendingExpiration:    "pending_expiration",
+		CustomHostnameSSLStatusExpired:              "expired",
+		CustomHostnameSSLStatusActive:               "active",
+		CustomHostnameSSLStatusInitializingTimedOut: "initializing_timed_out",
+		CustomHostnameSSLStatusValidationTimedOut:   "validation_timed_out",
+		CustomHostnameSSLStatusIssuanceTimedOut:     "issuance_timed_out",
+		CustomHostnameSSLStatusDeploymentTimedOut:   "deployment_timed_out",
+		CustomHostnameSSLStatusDeletionTimedOut:     "deletion_timed_out",
+		CustomHostnameSSLStatusPendingCleanup:       "pending_cleanup",
+		CustomHostnameSSLStatusStagingDeployment:    "staging_deployment",
+		CustomHostnameSSLStatusStagingActive:        "staging_active",
+		CustomHostnameSSLStatusDeactivating:         "deactivating",
+		CustomHostnameSSLStatusInactive:             "inactive",
+		CustomHostnameSSLStatusBackupIssued:         "backup_issued",
+		CustomHostnameSSLStatusHoldingDeployment:    "holding_deployment",
+	}
+	for got, want := range sslStatuses {
+		assert.Equal(t, want, got)
+	}
+
+	statuses := map[string]string{
+		CustomHostnameStatusActive:             "active",
+		CustomHostnameStatusPending:            "pending",
+		CustomHostnameStatusActiveRedeploying:  "active_redeploying",
+		CustomHostnameStatusMoved:              "moved",
+		CustomHostnameStatusPendingDeletion:    "pending_deletion",
+		CustomHostnameStatusDeleted:            "deleted",
+		CustomHostnameStatusPendingBlocked:     "pending_blocked",
+		CustomHostnameStatusPendingMigration:   "pending_migration",
+		CustomHostnameStatusPendingProvisioned: "pending_provisioned",
+		CustomHostnameStatusTestPending:        "test_pending",
+		CustomHostnameStatusTestActive:         "test_active",
+		CustomHostnameStatusTestActiveApex:     "test_active_apex",
+		CustomHostnameStatusTestBlocked:        "test_blocked",
+		CustomHostnameStatusTestFailed:         "test_failed",
+		CustomHostnameStatusProvisioned:        "provisioned",
+		CustomHostnameStatusBlocked:            "blocked",
+	}
+	for got, want := range statuses {
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestCustomHostname_CustomHostnamesCreatedAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"id": "old", "hostname": "old.example.com", "created_at": "2020-02-06T18:11:23.531995Z"},
+    {"id": "cutoff", "hostname": "cutoff.example.com", "created_at": "2020-03-01T00:00:00Z"},
+    {"id": "new", "hostname": "new.example.com", "created_at": "2020-03-02T09:30:00Z"},
+    {"id": "unknown", "hostname": "unknown.example.com"}
+  ],
+  "result_info": {"page": 1, "per_page": 50, "count": 4, "total_count": 4, "total_pages": 1}
+}`)
+	})
+
+	since, _ := time.Parse(time.RFC3339, "2020-03-01T00:00:00Z")
+	customHostnames, err := client.CustomHostnamesCreatedAfter("foo", since)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(customHostnames)) {
+			assert.Equal(t, "new", customHostnames[0].ID)
+		}
+	}
+}
+
+func TestCustomHostname_CustomHostnameStates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"id": "one", "hostname": "one.example.com", "status": "active", "ssl": {"status": "active"}},
+    {"id": "two", "hostname": "two.example.com", "status": "pending", "ssl": {"status": "pending_validation"}}
+  ],
+  "result_info": {"page": 1, "per_page": 2, "count": 2, "total_count": 3, "total_pages": 2}
+}`)
+		case "2":
+			fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {"id": "three", "hostname": "three.example.com", "status": "active", "ssl": {"status": "pending_deployment"}}
+  ],
+  "result_info": {"page": 2, "per_page": 2, "count": 1, "total_count": 3, "total_pages": 2}
+}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	want := map[string]CustomHostnameState{
+		"one":   {Status: CustomHostnameStatusActive, SSLStatus: CustomHostnameSSLStatusActive},
+		"two":   {Status: CustomHostnameStatusPending, SSLStatus: CustomHostnameSSLStatusPendingValidation},
+		"three": {Status: CustomHostnameStatusActive, SSLStatus: CustomHostnameSSLStatusPendingDeployment},
+	}
+
+	states, err := client.CustomHostnameStates("foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, states)
+		assert.True(t, states["one"].Active())
+		assert.False(t, states["three"].Active())
+	}
+}
+
+func TestCustomHostname_CreateCustomHostnamesPartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var ch CustomHostname
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&ch))
+		w.Header().Set("content-type", "application/json")
+		if ch.Hostname == "taken.example.com" {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1406, "message": "Duplicate custom hostname found."}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "id-%s", "hostname": "%s"}}`, ch.Hostname, ch.Hostname)
+	})
+
+	response, err := client.CreateCustomHostnames("foo", []CustomHostname{
+		{Hostname: "app.example.com"},
+		{Hostname: "taken.example.com"},
+		{Hostname: "www.example.com"},
+	})
+	if assert.Error(t, err) {
+		var multi *MultiError
+		if assert.True(t, errors.As(err, &multi)) && assert.Equal(t, 1, len(multi.Errors)) {
+			assert.Equal(t, "taken.example.com", multi.Errors[0].ID)
+		}
+	}
+	assert.False(t, response.Success)
+	if assert.Equal(t, 2, len(response.Result)) {
+		assert.Equal(t, "id-app.example.com", response.Result[0].ID)
+		assert.Equal(t, "id-www.example.com", response.Result[1].ID)
+	}
+}
+
+func TestCustomHostname_CreateCustomHostnamesContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests int32
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		atomic.AddInt32(&requests, 1)
+		var ch CustomHostname
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&ch))
+		if ch.Hostname == "www.example.com" {
+			// Cancel while this item is in flight; it must still complete.
+			cancel()
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "id-%s", "hostname": "%s"}}`, ch.Hostname, ch.Hostname)
+	})
+
+	response, err := client.CreateCustomHostnamesContext(ctx, "foo", []CustomHostname{
+		{Hostname: "app.example.com"},
+		{Hostname: "www.example.com"},
+		{Hostname: "api.example.com"},
+		{Hostname: "cdn.example.com"},
+	})
+	assert.True(t, errors.Is(err, context.Canceled))
+	var multi *MultiError
+	if assert.True(t, errors.As(err, &multi)) && assert.Equal(t, 2, len(multi.Errors)) {
+		assert.Equal(t, "api.example.com", multi.Errors[0].ID)
+		assert.Equal(t, "cdn.example.com", multi.Errors[1].ID)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.False(t, response.Success)
+	if assert.Equal(t, 2, len(response.Result)) {
+		assert.Equal(t, "id-app.example.com", response.Result[0].ID)
+		assert.Equal(t, "id-www.example.com", response.Result[1].ID)
+	}
+}
+
+func TestCustomHostname_DeleteCustomHostnames(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Path == "/zones/foo/custom_hostnames/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1436, "message": "The custom hostname was not found."}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "bar"}`)
+	})
+
+	deleted, err := client.DeleteCustomHostnames("foo", []string{"a", "missing", "b"})
+	var multi *MultiError
+	if assert.True(t, errors.As(err, &multi)) && assert.Equal(t, 1, len(multi.Errors)) {
+		assert.Equal(t, "missing", multi.Errors[0].ID)
+	}
+	assert.Equal(t, []string{"a", "b"}, deleted)
+}
+
+func TestCustomHostname_DeleteCustomHostnamesContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests int32
+	mux.HandleFunc("/zones/foo/custom_hostnames/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		if atomic.AddInt32(&requests, 1) == 2 {
+			// Cancel while this item is in flight; it must still complete.
+			cancel()
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"id": "bar"}`)
+	})
+
+	deleted, err := client.DeleteCustomHostnamesContext(ctx, "foo", []string{"a", "b", "c"})
+	assert.True(t, errors.Is(err, context.Canceled))
+	var multi *MultiError
+	if assert.True(t, errors.As(err, &multi)) && assert.Equal(t, 1, len(multi.Errors)) {
+		assert.Equal(t, "c", multi.Errors[0].ID)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.Equal(t, []string{"a", "b"}, deleted)
+}
+
+func TestCustomHostname_BulkResponseSingleObject(t *testing.T) {
+	var single CustomHostnameBulkResponse
+	err := json.Unmarshal([]byte(`{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "hostname": "app.example.com"}}`), &single)
+	if assert.NoError(t, err) {
+		assert.True(t, single.Success)
+		assert.Equal(t, []CustomHostname{{ID: "bar", Hostname: "app.example.com"}}, single.Result)
+	}
+
+	var many CustomHostnameBulkResponse
+	err = json.Unmarshal([]byte(`{"success": true, "errors": [], "messages": [], "result": [{"id": "a"}, {"id": "b"}]}`), &many)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(many.Result))
+	}
+}
+
+func TestCustomHostname_ActiveCertificateDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+    "hostname": "app.example.com",
+    "ssl": {
+      "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+      "status": "active",
+      "method": "http",
+      "type": "dv",
+      "bundle_method": "ubiquitous",
+      "certificate_authority": "lets_encrypt",
+      "issuer": "LetsEncrypt",
+      "serial_number": "3ad4b24795fd47846fd7a7f32645d7c4c2bd",
+      "signature": "SHA256WithRSA",
+      "uploaded_on": "2020-02-06T18:11:23.531995Z",
+      "expires_on": "2020-05-06T17:11:23Z",
+      "wildcard": false
+    },
+    "status": "active",
+    "created_at": "2020-02-06T18:11:23.531995Z"
+  }
+}`)
+	})
+
+	uploadedOn, _ := time.Parse(time.RFC3339, "2020-02-06T18:11:23.531995Z")
+	expiresOn, _ := time.Parse(time.RFC3339, "2020-05-06T17:11:23Z")
+
+	ch, err := client.CustomHostname("foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9")
+	if assert.NoError(t, err) {
+		assert.Equal(t, CustomHostnameSSL{
+			Status:       CustomHostnameSSLStatusActive,
+			Method:       "http",
+			Type:         "dv",
+			Issuer:       "LetsEncrypt",
+			SerialNumber: "3ad4b24795fd47846fd7a7f32645d7c4c2bd",
+			Signature:    "SHA256WithRSA",
+			UploadedOn:   &uploadedOn,
+			ExpiresOn:    &expiresOn,
+		}, ch.SSL)
+	}
+}
+
+func TestCustomHostname_CreateCustomHostnameContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	delay := 5 * time.Second
+	mux.HandleFunc("/zones/foo/custom_hostnames", slowHandler(delay, `{"success": true, "errors": [], "messages": [], "result": {"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9"}}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.CreateCustomHostnameContext(ctx, "foo", CustomHostname{Hostname: "app.example.com"})
+	assertAbortedPromptly(t, err, context.Canceled, time.Since(start), delay)
+}
+
+func TestCustomHostname_CustomHostnameContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	delay := 5 * time.Second
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", slowHandler(delay, `{"success": true, "errors": [], "messages": [], "result": {"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9"}}`))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.CustomHostnameContext(ctx, "foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9")
+	assertAbortedPromptly(t, err, context.DeadlineExceeded, time.Since(start), delay)
+}
+
+func TestCustomHostname_CustomHostnameIDByNameContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	delay := 5 * time.Second
+	mux.HandleFunc("/zones/foo/custom_hostnames", slowHandler(delay, `{"success": true, "errors": [], "messages": [], "result": []}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.CustomHostnameIDByNameContext(ctx, "foo", "app.example.com")
+	assertAbortedPromptly(t, err, context.Canceled, time.Since(start), delay)
+}
+
+func TestCustomHostname_UpdateCustomHostnameRoundTrip(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const fetched = `{
+    "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+    "hostname": "app.example.com",
+    "status": "pending",
+    "verification_errors": ["None of the A or AAAA records are owned by this account"],
+    "created_at": "2020-02-06T18:11:23.531995Z",
+    "custom_metadata": {"tenant": "acme"},
+    "ssl": {
+      "status": "pending_validation",
+      "method": "http",
+      "type": "dv",
+      "cname_target": "ssl.example.net",
+      "settings": {"min_tls_version": "1.2"},
+      "validation_errors": [{"message": "SERVFAIL looking up CAA for app.example.com"}],
+      "issuer": "DigiCert"
+    }
+  }`
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "hostname": "app.example.com",
+              "custom_metadata": {"tenant": "globex"},
+              "ssl": {"method": "http", "type": "dv", "settings": {"min_tls_version": "1.2"}}
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, fetched)
+	})
+
+	var ch CustomHostname
+	if !assert.NoError(t, json.Unmarshal([]byte(fetched), &ch)) {
+		return
+	}
+	ch.CustomMetadata = CustomMetadata{"tenant": "globex"}
+
+	_, err := client.UpdateCustomHostname("foo", ch.ID, ch)
+	assert.NoError(t, err)
+}
+
+func TestCustomHostname_UpdateCustomHostnameClearOrigin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"custom_metadata": {"tenant": "acme"}, "custom_origin_server": null}`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+    "hostname": "app.example.com",
+    "custom_metadata": {"tenant": "acme"}
+  }
+}`)
+	})
+
+	response, err := client.UpdateCustomHostname("foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9", CustomHostname{
+		CustomMetadata: CustomMetadata{"tenant": "acme"},
+	}, "custom_origin_server")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "", response.Result.CustomOriginServer)
+		assert.Equal(t, CustomMetadata{"tenant": "acme"}, response.Result.CustomMetadata)
+	}
+}
+
+func TestCustomHostname_UpdateCustomHostnameSetOrigin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"custom_origin_server": "origin.example.com", "ssl": {"method": "http", "type": "dv"}}`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+    "hostname": "app.example.com",
+    "custom_origin_server": "origin.example.com"
+  }
+}`)
+	})
+
+	response, err := client.UpdateCustomHostname("foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9", CustomHostname{
+		CustomOriginServer: "origin.example.com",
+		SSL:                CustomHostnameSSL{Method: "http", Type: "dv"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "origin.example.com", response.Result.CustomOriginServer)
+	}
+}
+
+func TestCustomHostname_UpdateCustomHostnameClearInvalidField(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateCustomHostname("foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9", CustomHostname{}, "hostname")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `invalid field "hostname" to clear`)
+	}
+}
+
+func TestCustomHostname_SaaSZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "saas.example.com", r.URL.Query().Get("name"))
+		assert.Equal(t, testAccountID, r.URL.Query().Get("account.id"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "023e105f4ecef8ad9ca31a8372d0c353",
+      "name": "saas.example.com",
+      "account": {"id": "01a7362d577a6c3019a474fd6f485823", "name": "SaaS Provider"}
+    }
+  ]
+}`)
+	})
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9", "hostname": "app.tenant.com"}
+}`)
+	})
+
+	zoneID, err := client.SaaSZoneID(testAccountID, "saas.example.com")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", zoneID)
+
+	response, err := client.CreateCustomHostname(zoneID, CustomHostname{Hostname: "app.tenant.com"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0d89c70d-ad9f-4843-b99f-6cc0252067e9", response.Result.ID)
+	}
+}
+
+func TestCustomHostname_SaaSZoneOtherAccount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "023e105f4ecef8ad9ca31a8372d0c353",
+      "name": "saas.example.com",
+      "account": {"id": "9a7806061c88ada191ed06f989cc3dac", "name": "Someone Else"}
+    }
+  ]
+}`)
+	})
+
+	_, err := client.SaaSZoneID(testAccountID, "saas.example.com")
+	if assert.Error(t, err) {
+		assert.Equal(t, "SaaS zone saas.example.com could not be found in account 01a7362d577a6c3019a474fd6f485823", err.Error())
+	}
+}
+
+func TestCustomHostname_CustomHostnamesEmptySSL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+"success": true,
+"result": [
+    {
+      "id": "custom_host_1",
+      "hostname": "custom.host.one",
+      "ssl": {"type": "dv", "method": "http", "status": "active"}
+    },
+    {
+      "id": "custom_host_2",
+      "hostname": "custom.host.two",
+      "ssl": ""
+    },
+    {
+      "id": "custom_host_3",
+      "hostname": "custom.host.three",
+      "ssl": null
+    }
+],
+"result_info": {"page": 1, "per_page": 20, "count": 3, "total_count": 3}
+}`)
+	})
+
+	customHostnames, _, err := client.CustomHostnames("foo", 1, CustomHostname{})
+
+	want := []CustomHostname{
+		{ID: "custom_host_1", Hostname: "custom.host.one", SSL: CustomHostnameSSL{Type: "dv", Method: "http", Status: "active"}},
+		{ID: "custom_host_2", Hostname: "custom.host.two"},
+		{ID: "custom_host_3", Hostname: "custom.host.three"},
+	}
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, customHostnames)
+	}
+}
+
+func TestCustomHostnameSSL_UnmarshalJSONInvalid(t *testing.T) {
+	var ssl CustomHostnameSSL
+	assert.Error(t, json.Unmarshal([]byte(`"dv"`), &ssl))
+}
+
+func TestCustomHostname_CustomHostnameApproverEmails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames/0d89c70d-ad9f-4843-b99f-6cc0252067e9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+    "hostname": "app.example.com",
+    "ssl": {
+      "status": "pending_validation",
+      "method": "email",
+      "type": "dv",
+      "validation_records": [
+        {"emails": ["administrator@example.com", "webmaster@example.com"]},
+        {"emails": ["webmaster@example.com", "hostmaster@example.com"]}
+      ]
+    }
+  }
+}`)
+	})
+
+	emails, err := client.CustomHostnameApproverEmails("foo", "0d89c70d-ad9f-4843-b99f-6cc0252067e9")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"administrator@example.com", "webmaster@example.com", "hostmaster@example.com"}, emails)
+	}
+}
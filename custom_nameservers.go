@@ -0,0 +1,136 @@
+package cloudflare
+
+import (
+	"github.com/pkg/errors"
+)
+
+// CustomNameserver describes an account custom nameserver to create.
+// Nameservers sharing an NSSet are assigned to zones together.
+type CustomNameserver struct {
+	NSName string `json:"ns_name"`
+	NSSet  int    `json:"ns_set,omitempty"`
+}
+
+// CustomNameserverRecord is a DNS record serving the address of a custom
+// nameserver.
+type CustomNameserverRecord struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomNameserverResult describes an account custom nameserver. Status is
+// one of "moved", "pending" or "verified".
+type CustomNameserverResult struct {
+	NSName     string                   `json:"ns_name"`
+	NSSet      int                      `json:"ns_set"`
+	Status     string                   `json:"status"`
+	ZoneTag    string                   `json:"zone_tag"`
+	DNSRecords []CustomNameserverRecord `json:"dns_records"`
+}
+
+// ZoneCustomNameservers describes whether a zone uses the account custom
+// nameservers of the given set.
+type ZoneCustomNameservers struct {
+	Enabled bool `json:"enabled"`
+	NSSet   int  `json:"ns_set,omitempty"`
+}
+
+// CustomNameserverResponse represents the response from the custom
+// nameserver endpoints containing a single nameserver.
+type CustomNameserverResponse struct {
+	Response
+	Result CustomNameserverResult `json:"result"`
+}
+
+// CustomNameserverListResponse represents the response from the list custom
+// nameservers endpoint.
+type CustomNameserverListResponse struct {
+	Response
+	Result []CustomNameserverResult `json:"result"`
+}
+
+// ZoneCustomNameserversResponse represents the response from the zone custom
+// nameserver endpoints.
+type ZoneCustomNameserversResponse struct {
+	Response
+	Result ZoneCustomNameservers `json:"result"`
+}
+
+// CreateCustomNameservers adds a custom nameserver to the given account.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-add-account-custom-nameserver
+func (api *API) CreateCustomNameservers(accountID string, cns CustomNameserver) (CustomNameserverResult, error) {
+	uri := "/accounts/" + accountID + "/custom_ns"
+	res, err := api.makeRequest("POST", uri, cns)
+	if err != nil {
+		return CustomNameserverResult{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r CustomNameserverResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return CustomNameserverResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CustomNameservers returns the custom nameservers of the given account,
+// including their verification status.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-list-account-custom-nameservers
+func (api *API) CustomNameservers(accountID string) ([]CustomNameserverResult, error) {
+	uri := "/accounts/" + accountID + "/custom_ns"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []CustomNameserverResult{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r CustomNameserverListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []CustomNameserverResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteCustomNameservers removes the named custom nameserver from the given
+// account.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-delete-account-custom-nameserver
+func (api *API) DeleteCustomNameservers(accountID, nsName string) error {
+	uri := "/accounts/" + accountID + "/custom_ns/" + nsName
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// ZoneCustomNameservers returns whether the given zone uses account custom
+// nameservers.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-usage-for-a-zone-get-account-custom-nameserver-related-zone-metadata
+func (api *API) ZoneCustomNameservers(zoneID string) (ZoneCustomNameservers, error) {
+	uri := "/zones/" + zoneID + "/custom_ns"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ZoneCustomNameservers{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneCustomNameserversResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ZoneCustomNameservers{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateZoneCustomNameservers toggles the use of the account custom
+// nameservers of the given set for the given zone.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-usage-for-a-zone-set-usage-of-account-custom-nameservers
+func (api *API) UpdateZoneCustomNameservers(zoneID string, conf ZoneCustomNameservers) (ZoneCustomNameservers, error) {
+	uri := "/zones/" + zoneID + "/custom_ns"
+	res, err := api.makeRequest("PUT", uri, conf)
+	if err != nil {
+		return ZoneCustomNameservers{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneCustomNameserversResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ZoneCustomNameservers{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
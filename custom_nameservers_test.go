@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCustomNameservers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/custom_ns", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"ns_name": "ns1.example.com", "ns_set": 1}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "dns_records": [
+              {"type": "A", "value": "1.1.1.1"},
+              {"type": "AAAA", "value": "2400:cb00:2049:1::a29f:1804"}
+            ],
+            "ns_name": "ns1.example.com",
+            "ns_set": 1,
+            "status": "pending",
+            "zone_tag": "023e105f4ecef8ad9ca31a8372d0c353"
+          }
+        }`)
+	})
+
+	want := CustomNameserverResult{
+		NSName:  "ns1.example.com",
+		NSSet:   1,
+		Status:  "pending",
+		ZoneTag: "023e105f4ecef8ad9ca31a8372d0c353",
+		DNSRecords: []CustomNameserverRecord{
+			{Type: "A", Value: "1.1.1.1"},
+			{Type: "AAAA", Value: "2400:cb00:2049:1::a29f:1804"},
+		},
+	}
+
+	actual, err := client.CreateCustomNameservers(testAccountID, CustomNameserver{NSName: "ns1.example.com", NSSet: 1})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCustomNameservers_VerificationStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/custom_ns", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {"dns_records": [], "ns_name": "ns1.example.com", "ns_set": 1, "status": "verified", "zone_tag": "023e105f4ecef8ad9ca31a8372d0c353"},
+            {"dns_records": [], "ns_name": "ns2.example.com", "ns_set": 1, "status": "pending", "zone_tag": "023e105f4ecef8ad9ca31a8372d0c353"}
+          ]
+        }`)
+	})
+
+	nameservers, err := client.CustomNameservers(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 2, len(nameservers)) {
+			assert.Equal(t, "verified", nameservers[0].Status)
+			assert.Equal(t, "pending", nameservers[1].Status)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DevicePostureRule describes a device posture check of the WARP client.
+//
+// Type is the kind of check, e.g. "os_version", "file", "application",
+// "serial_number", "firewall" or "disk_encryption". Match restricts the
+// check to platforms and Input holds its type specific parameters. Schedule
+// is how often the check runs, e.g. "5m" or "1h".
+type DevicePostureRule struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Type        string                   `json:"type"`
+	Schedule    string                   `json:"schedule,omitempty"`
+	Expiration  string                   `json:"expiration,omitempty"`
+	Match       []DevicePostureRuleMatch `json:"match,omitempty"`
+	Input       DevicePostureRuleInput   `json:"input"`
+}
+
+// DevicePostureRuleMatch restricts a posture rule to a platform: "windows",
+// "mac", "linux", "android" or "ios".
+type DevicePostureRuleMatch struct {
+	Platform string `json:"platform"`
+}
+
+// DevicePostureRuleInput holds the parameters of a posture rule. Only the
+// fields relevant to the rule's type are set.
+type DevicePostureRuleInput struct {
+	ID         string `json:"id,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Exists     bool   `json:"exists,omitempty"`
+	Thumbprint string `json:"thumbprint,omitempty"`
+	Sha256     string `json:"sha256,omitempty"`
+	Running    bool   `json:"running,omitempty"`
+	RequireAll bool   `json:"requireAll,omitempty"`
+	Enabled    bool   `json:"enabled,omitempty"`
+	// Version and Operator compare the OS version, e.g. ">=" "10.0.19041".
+	Version  string `json:"version,omitempty"`
+	Operator string `json:"operator,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+}
+
+// Device describes a device enrolled with the WARP client.
+type Device struct {
+	ID         string     `json:"id"`
+	Key        string     `json:"key,omitempty"`
+	Name       string     `json:"name"`
+	DeviceType string     `json:"device_type"`
+	Model      string     `json:"model,omitempty"`
+	OSVersion  string     `json:"os_version,omitempty"`
+	Version    string     `json:"version,omitempty"`
+	IP         string     `json:"ip,omitempty"`
+	User       DeviceUser `json:"user"`
+	Created    *time.Time `json:"created,omitempty"`
+	Updated    *time.Time `json:"updated,omitempty"`
+	LastSeen   *time.Time `json:"last_seen,omitempty"`
+	Deleted    bool       `json:"deleted"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// DeviceUser is the user a device is enrolled by.
+type DeviceUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// DevicePostureRuleResponse represents the response from the device posture
+// endpoints containing a single rule.
+type DevicePostureRuleResponse struct {
+	Response
+	Result DevicePostureRule `json:"result"`
+}
+
+// DevicePostureRulesResponse represents the response from the list device
+// posture rules endpoint.
+type DevicePostureRulesResponse struct {
+	Response
+	Result []DevicePostureRule `json:"result"`
+}
+
+// DevicesResponse represents the response from the list devices endpoint.
+type DevicesResponse struct {
+	Response
+	Result []Device `json:"result"`
+}
+
+// DevicePostureRules returns the device posture rules of the given account.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-list-device-posture-rules
+func (api *API) DevicePostureRules(accountID string) ([]DevicePostureRule, error) {
+	uri := "/accounts/" + accountID + "/devices/posture"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []DevicePostureRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r DevicePostureRulesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []DevicePostureRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateDevicePostureRule creates a device posture rule in the given account.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-create-device-posture-rule
+func (api *API) CreateDevicePostureRule(accountID string, rule DevicePostureRule) (DevicePostureRule, error) {
+	uri := "/accounts/" + accountID + "/devices/posture"
+	res, err := api.makeRequest("POST", uri, rule)
+	if err != nil {
+		return DevicePostureRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r DevicePostureRuleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return DevicePostureRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteDevicePostureRule deletes the given device posture rule.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-delete-device-posture-rule
+func (api *API) DeleteDevicePostureRule(accountID, ruleID string) error {
+	uri := "/accounts/" + accountID + "/devices/posture/" + ruleID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// Devices returns the devices enrolled in the given account.
+//
+// API reference: https://api.cloudflare.com/#devices-list-devices
+func (api *API) Devices(accountID string) ([]Device, error) {
+	uri := "/accounts/" + accountID + "/devices"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []Device{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r DevicesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []Device{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// RevokeDevices revokes the registrations of the given devices, which have
+// to enroll again to regain access.
+//
+// API reference: https://api.cloudflare.com/#devices-revoke-devices
+func (api *API) RevokeDevices(accountID string, deviceIDs []string) error {
+	uri := "/accounts/" + accountID + "/devices/revoke"
+	if _, err := api.makeRequest("POST", uri, deviceIDs); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
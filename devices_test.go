@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDevicePostureRule_OSVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/posture", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "Windows 10 or later",
+              "type": "os_version",
+              "schedule": "1h",
+              "match": [{"platform": "windows"}],
+              "input": {"version": "10.0.0", "operator": ">="}
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+            "name": "Windows 10 or later",
+            "type": "os_version",
+            "schedule": "1h",
+            "match": [{"platform": "windows"}],
+            "input": {"version": "10.0.0", "operator": ">="}
+          }
+        }`)
+	})
+
+	want := DevicePostureRule{
+		ID:       "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+		Name:     "Windows 10 or later",
+		Type:     "os_version",
+		Schedule: "1h",
+		Match:    []DevicePostureRuleMatch{{Platform: "windows"}},
+		Input:    DevicePostureRuleInput{Version: "10.0.0", Operator: ">="},
+	}
+
+	rule := want
+	rule.ID = ""
+	actual, err := client.CreateDevicePostureRule(testAccountID, rule)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+              "key": "yek0SUYoOQ10vMGsIYEevozXUQpQtNFJFfFGqER/BGc=",
+              "name": "My mobile device",
+              "device_type": "ios",
+              "model": "iPhone12,1",
+              "os_version": "14.4",
+              "version": "1.0.0",
+              "ip": "192.0.2.1",
+              "user": {"id": "f3b12456-80dd-4e89-9f5f-ba3dfff12365", "email": "user@example.com", "name": "John Appleseed"},
+              "created": "2017-06-14T00:00:00Z",
+              "updated": "2017-06-14T00:00:00Z",
+              "last_seen": "2017-06-14T00:00:00Z",
+              "deleted": false
+            }
+          ]
+        }`)
+	})
+
+	devices, err := client.Devices(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(devices)) {
+			assert.Equal(t, "ios", devices[0].DeviceType)
+			assert.Equal(t, "user@example.com", devices[0].User.Email)
+			assert.NotNil(t, devices[0].LastSeen)
+			assert.Nil(t, devices[0].RevokedAt)
+		}
+	}
+}
+
+func TestRevokeDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/revoke", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `["f174e90a-fafe-4643-bbbc-4a0ed4fc8415"]`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	})
+
+	err := client.RevokeDevices(testAccountID, []string{"f174e90a-fafe-4643-bbbc-4a0ed4fc8415"})
+	assert.NoError(t, err)
+}
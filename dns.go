@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"net/url"
 	"strconv"
 	"time"
@@ -26,6 +25,9 @@ type DNSRecord struct {
 	Data       interface{} `json:"data,omitempty"` // data returned by: SRV, LOC
 	Meta       interface{} `json:"meta,omitempty"`
 	Priority   int         `json:"priority,omitempty"`
+	Comment    string      `json:"comment,omitempty"`
+	// Tags are "name:value" pairs, or a bare name for a tag without value.
+	Tags []string `json:"tags,omitempty"`
 }
 
 // DNSRecordResponse represents the response from the DNS endpoint.
@@ -53,7 +55,7 @@ func (api *API) CreateDNSRecord(zoneID string, rr DNSRecord) (*DNSRecordResponse
 	}
 
 	var recordResp *DNSRecordResponse
-	err = json.Unmarshal(res, &recordResp)
+	err = api.unmarshal(res, &recordResp)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -63,7 +65,8 @@ func (api *API) CreateDNSRecord(zoneID string, rr DNSRecord) (*DNSRecordResponse
 
 // DNSRecords returns a slice of DNS records for the given zone identifier.
 //
-// This takes a DNSRecord to allow filtering of the results returned.
+// This takes a DNSRecord to allow filtering of the results returned. Records
+// are matched on the exact Comment, and must carry every one of the Tags.
 //
 // API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
 func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
@@ -80,6 +83,12 @@ func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
 	if rr.Content != "" {
 		v.Set("content", rr.Content)
 	}
+	if rr.Comment != "" {
+		v.Set("comment", rr.Comment)
+	}
+	for _, tag := range rr.Tags {
+		v.Add("tag.exact", tag)
+	}
 
 	var query string
 	var records []DNSRecord
@@ -95,7 +104,7 @@ func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
 			return []DNSRecord{}, errors.Wrap(err, errMakeRequestError)
 		}
 		var r DNSListResponse
-		err = json.Unmarshal(res, &r)
+		err = api.unmarshal(res, &r)
 		if err != nil {
 			return []DNSRecord{}, errors.Wrap(err, errUnmarshalError)
 		}
@@ -109,6 +118,34 @@ func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
 	return records, nil
 }
 
+// DNSRecordExists reports whether a DNS record with the given name and type
+// exists in the given zone and, if so, returns the ID of the first match.
+// Only a single record is requested, so this is cheaper than DNSRecords for
+// an existence check.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
+func (api *API) DNSRecordExists(zoneID, name, recordType string) (bool, string, error) {
+	v := url.Values{}
+	v.Set("per_page", "1")
+	v.Set("name", name)
+	if recordType != "" {
+		v.Set("type", recordType)
+	}
+	uri := "/zones/" + zoneID + "/dns_records?" + v.Encode()
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return false, "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r DNSListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return false, "", errors.Wrap(err, errUnmarshalError)
+	}
+	if len(r.Result) == 0 {
+		return false, "", nil
+	}
+	return true, r.Result[0].ID, nil
+}
+
 // DNSRecord returns a single DNS record for the given zone & record
 // identifiers.
 //
@@ -120,7 +157,7 @@ func (api *API) DNSRecord(zoneID, recordID string) (DNSRecord, error) {
 		return DNSRecord{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r DNSRecordResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return DNSRecord{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -148,13 +185,36 @@ func (api *API) UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	var r DNSRecordResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
 	return nil
 }
 
+// RetargetDNSRecords updates every DNS record in the zone whose content is
+// exactly oldContent to point at newContent instead, leaving all other fields
+// untouched. It returns the number of records that were changed; on error,
+// the count reflects the records updated before the failure.
+func (api *API) RetargetDNSRecords(zoneID, oldContent, newContent string) (int, error) {
+	records, err := api.DNSRecords(zoneID, DNSRecord{Content: oldContent})
+	if err != nil {
+		return 0, err
+	}
+	var changed int
+	for _, rec := range records {
+		if rec.Content != oldContent {
+			continue
+		}
+		rec.Content = newContent
+		if err := api.UpdateDNSRecord(zoneID, rec.ID, rec); err != nil {
+			return changed, errors.Wrapf(err, "failed to retarget DNS record %s", rec.ID)
+		}
+		changed++
+	}
+	return changed, nil
+}
+
 // DeleteDNSRecord deletes a single DNS record for the given zone & record
 // identifiers.
 //
@@ -166,9 +226,70 @@ func (api *API) DeleteDNSRecord(zoneID, recordID string) error {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	var r DNSRecordResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
 	return nil
 }
+
+// ZoneDNSSettings describes the DNS settings of a zone. The boolean fields
+// are pointers so that an update only sends the settings that were set.
+type ZoneDNSSettings struct {
+	Nameservers   *ZoneDNSSettingsNameservers `json:"nameservers,omitempty"`
+	FoundationDNS *bool                       `json:"foundation_dns,omitempty"`
+	MultiProvider *bool                       `json:"multi_provider,omitempty"`
+	// SecondaryOverrides allows a secondary zone to override proxied records
+	// and configure CNAME flattening at the zone apex.
+	SecondaryOverrides *bool `json:"secondary_overrides,omitempty"`
+}
+
+// ZoneDNSSettingsNameservers describes the nameservers assigned to a zone.
+//
+// Type is one of "cloudflare.standard", "cloudflare.foundation_dns" or
+// "custom.account".
+type ZoneDNSSettingsNameservers struct {
+	Type string `json:"type"`
+}
+
+// ZoneDNSSettingsResponse represents the response from the zone DNS settings
+// endpoint.
+type ZoneDNSSettingsResponse struct {
+	Response
+	Result ZoneDNSSettings `json:"result"`
+}
+
+// ZoneDNSSettings returns the DNS settings of the given zone.
+//
+// API reference: https://api.cloudflare.com/#dns-settings-for-a-zone-show-dns-settings
+func (api *API) ZoneDNSSettings(zoneID string) (ZoneDNSSettings, error) {
+	uri := "/zones/" + zoneID + "/dns_settings"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ZoneDNSSettings{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneDNSSettingsResponse
+	err = api.unmarshal(res, &r)
+	if err != nil {
+		return ZoneDNSSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateZoneDNSSettings updates the DNS settings of the given zone. Only the
+// non-nil fields of settings are changed.
+//
+// API reference: https://api.cloudflare.com/#dns-settings-for-a-zone-update-dns-settings
+func (api *API) UpdateZoneDNSSettings(zoneID string, settings ZoneDNSSettings) (ZoneDNSSettings, error) {
+	uri := "/zones/" + zoneID + "/dns_settings"
+	res, err := api.makeRequest("PATCH", uri, settings)
+	if err != nil {
+		return ZoneDNSSettings{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneDNSSettingsResponse
+	err = api.unmarshal(res, &r)
+	if err != nil {
+		return ZoneDNSSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,302 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneDNSSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "nameservers": {
+              "type": "cloudflare.standard"
+            },
+            "foundation_dns": false,
+            "multi_provider": false,
+            "secondary_overrides": false
+          }
+        }`)
+	})
+
+	disabled := false
+	want := ZoneDNSSettings{
+		Nameservers:        &ZoneDNSSettingsNameservers{Type: "cloudflare.standard"},
+		FoundationDNS:      &disabled,
+		MultiProvider:      &disabled,
+		SecondaryOverrides: &disabled,
+	}
+
+	actual, err := client.ZoneDNSSettings("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateZoneDNSSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"multi_provider":true}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "nameservers": {
+              "type": "cloudflare.standard"
+            },
+            "foundation_dns": false,
+            "multi_provider": true,
+            "secondary_overrides": false
+          }
+        }`)
+	})
+
+	enabled := true
+	actual, err := client.UpdateZoneDNSSettings("023e105f4ecef8ad9ca31a8372d0c353", ZoneDNSSettings{MultiProvider: &enabled})
+	if assert.NoError(t, err) {
+		assert.Equal(t, &enabled, actual.MultiProvider)
+		assert.Equal(t, "cloudflare.standard", actual.Nameservers.Type)
+	}
+}
+
+func TestCreateDNSRecord_CommentAndTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "type": "A",
+              "name": "www.example.com",
+              "content": "198.51.100.4",
+              "comment": "Web server",
+              "tags": ["owner:web-team", "production"],
+              "created_on": "0001-01-01T00:00:00Z",
+              "modified_on": "0001-01-01T00:00:00Z"
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "372e67954025e0ba6aaa6d586b9e0b59",
+            "type": "A",
+            "name": "www.example.com",
+            "content": "198.51.100.4",
+            "comment": "Web server",
+            "tags": ["owner:web-team", "production"]
+          }
+        }`)
+	})
+
+	resp, err := client.CreateDNSRecord("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{
+		Type:    "A",
+		Name:    "www.example.com",
+		Content: "198.51.100.4",
+		Comment: "Web server",
+		Tags:    []string{"owner:web-team", "production"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Web server", resp.Result.Comment)
+		assert.Equal(t, []string{"owner:web-team", "production"}, resp.Result.Tags)
+	}
+}
+
+func TestDNSRecords_FilterByTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, []string{"owner:web-team", "production"}, r.URL.Query()["tag.exact"])
+		assert.Equal(t, "Web server", r.URL.Query().Get("comment"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "372e67954025e0ba6aaa6d586b9e0b59",
+              "type": "A",
+              "name": "www.example.com",
+              "content": "198.51.100.4",
+              "comment": "Web server",
+              "tags": ["owner:web-team", "production"]
+            }
+          ],
+          "result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1, "total_pages": 1}
+        }`)
+	})
+
+	records, err := client.DNSRecords("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{
+		Comment: "Web server",
+		Tags:    []string{"owner:web-team", "production"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, len(records))
+		assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b59", records[0].ID)
+	}
+}
+
+func TestDNSRecordExists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "www.example.com", r.URL.Query().Get("name"))
+		assert.Equal(t, "A", r.URL.Query().Get("type"))
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "372e67954025e0ba6aaa6d586b9e0b59",
+              "type": "A",
+              "name": "www.example.com",
+              "content": "198.51.100.4"
+            }
+          ],
+          "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 1, "total_pages": 1}
+        }`)
+	})
+
+	exists, id, err := client.DNSRecordExists("023e105f4ecef8ad9ca31a8372d0c353", "www.example.com", "A")
+	if assert.NoError(t, err) {
+		assert.True(t, exists)
+		assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b59", id)
+	}
+}
+
+func TestDNSRecordExistsAbsent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [],
+          "result_info": {"page": 1, "per_page": 1, "count": 0, "total_count": 0, "total_pages": 0}
+        }`)
+	})
+
+	exists, id, err := client.DNSRecordExists("023e105f4ecef8ad9ca31a8372d0c353", "www.example.com", "A")
+	if assert.NoError(t, err) {
+		assert.False(t, exists)
+		assert.Equal(t, "", id)
+	}
+}
+
+func TestRetargetDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	records := map[string]string{
+		"372e67954025e0ba6aaa6d586b9e0b59": `{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "www.example.com", "content": "198.51.100.4", "ttl": 120}`,
+		"9a7806061c88ada191ed06f989cc3dac": `{"id": "9a7806061c88ada191ed06f989cc3dac", "type": "A", "name": "api.example.com", "content": "198.51.100.4", "proxied": true}`,
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "198.51.100.4", r.URL.Query().Get("content"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            %s,
+            %s,
+            {"id": "b3d9a6e0b9f2c2d1e6a1e8f4c5d7a9b0", "type": "A", "name": "mail.example.com", "content": "198.51.100.40"}
+          ],
+          "result_info": {"page": 1, "per_page": 50, "count": 3, "total_count": 3, "total_pages": 1}
+        }`, records["372e67954025e0ba6aaa6d586b9e0b59"], records["9a7806061c88ada191ed06f989cc3dac"])
+	})
+
+	var updated []string
+	for id, record := range records {
+		id, record := id, record
+		mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records/"+id, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("content-type", "application/json")
+			switch r.Method {
+			case "GET":
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, record)
+			case "PUT":
+				b, err := ioutil.ReadAll(r.Body)
+				defer r.Body.Close()
+				if assert.NoError(t, err) {
+					assert.Contains(t, string(b), `"content":"203.0.113.9"`)
+				}
+				updated = append(updated, id)
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, record)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		})
+	}
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records/b3d9a6e0b9f2c2d1e6a1e8f4c5d7a9b0", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("non-matching record should not be updated")
+	})
+
+	changed, err := client.RetargetDNSRecords("023e105f4ecef8ad9ca31a8372d0c353", "198.51.100.4", "203.0.113.9")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, changed)
+		assert.Equal(t, []string{"372e67954025e0ba6aaa6d586b9e0b59", "9a7806061c88ada191ed06f989cc3dac"}, updated)
+	}
+}
+
+func TestRetargetDNSRecords_NoMatches(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [],
+          "result_info": {"page": 1, "per_page": 50, "count": 0, "total_count": 0, "total_pages": 0}
+        }`)
+	})
+
+	changed, err := client.RetargetDNSRecords("023e105f4ecef8ad9ca31a8372d0c353", "198.51.100.4", "203.0.113.9")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, changed)
+	}
+}
@@ -0,0 +1,84 @@
+package cloudflare
+
+import (
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// DurableObjectNamespace describes the namespace of a Durable Object class
+// implemented by a worker script.
+type DurableObjectNamespace struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Script string `json:"script"`
+	Class  string `json:"class"`
+}
+
+// DurableObject identifies a single object of a Durable Object namespace.
+type DurableObject struct {
+	ID            string `json:"id"`
+	HasStoredData bool   `json:"hasStoredData"`
+}
+
+// DurableObjectNamespacesResponse represents the response from the list
+// Durable Object namespaces endpoint.
+type DurableObjectNamespacesResponse struct {
+	Response
+	Result     []DurableObjectNamespace `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// DurableObjectsResponse represents the response from the list Durable
+// Objects endpoint.
+type DurableObjectsResponse struct {
+	Response
+	Result     []DurableObject `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// ListDurableObjectNamespaces returns all Durable Object namespaces of the
+// given account.
+//
+// API reference: https://api.cloudflare.com/#durable-objects-namespace-list-namespaces
+func (api *API) ListDurableObjectNamespaces(accountID string) ([]DurableObjectNamespace, error) {
+	var namespaces []DurableObjectNamespace
+	uri := "/accounts/" + accountID + "/workers/durable_objects/namespaces"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 100}, 100, func(res []byte) (ResultInfo, error) {
+		var r DurableObjectNamespacesResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		namespaces = append(namespaces, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []DurableObjectNamespace{}, err
+	}
+	return namespaces, nil
+}
+
+// ListDurableObjects returns the objects of the given Durable Object
+// namespace, following the result cursor until the last page. Only objects
+// which have stored data are listed by the API.
+//
+// API reference: https://api.cloudflare.com/#durable-objects-namespace-list-objects
+func (api *API) ListDurableObjects(accountID, namespaceID string) ([]DurableObject, error) {
+	if namespaceID == "" {
+		return []DurableObject{}, errors.New("Durable Object namespace ID cannot be empty")
+	}
+	var objects []DurableObject
+	uri := "/accounts/" + accountID + "/workers/durable_objects/namespaces/" + namespaceID + "/objects"
+	err := api.forEachCursorPage(uri, url.Values{"limit": {"1000"}}, false, func(res []byte) (ResultInfo, error) {
+		var r DurableObjectsResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		objects = append(objects, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []DurableObject{}, err
+	}
+	return objects, nil
+}
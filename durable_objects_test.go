@@ -0,0 +1,93 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDurableObjectNamespaces(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/durable_objects/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "5fd1cafff895419c8bcc647fc64ab8f0", "name": "chat_ChatRoom", "script": "chat", "class": "ChatRoom"}],
+              "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+            }`)
+		case "2":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "8f0d4c1a3e2b4d6f9a7c5e3b1d2f4a6c", "name": "chat_RateLimiter", "script": "chat", "class": "RateLimiter"}],
+              "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+            }`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	namespaces, err := client.ListDurableObjectNamespaces(testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []DurableObjectNamespace{
+			{ID: "5fd1cafff895419c8bcc647fc64ab8f0", Name: "chat_ChatRoom", Script: "chat", Class: "ChatRoom"},
+			{ID: "8f0d4c1a3e2b4d6f9a7c5e3b1d2f4a6c", Name: "chat_RateLimiter", Script: "chat", Class: "RateLimiter"},
+		}, namespaces)
+	}
+}
+
+func TestListDurableObjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/durable_objects/namespaces/5fd1cafff895419c8bcc647fc64ab8f0/objects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "fe7803fc55b964e09d94666545aab688d360c6bda69ba349ced1e5f28d2fc2c8", "hasStoredData": true}],
+              "result_info": {"count": 1, "cursor": "AAAAANuhDN7SjacTnSVsDu3WW1Lvst6dxJGTjRY5BhxPXdf6L6uTcpd_NVtjhn11OUYRsVEykxoUwF-JQU4dn6QylZSKTOJuG0indrdn_MlHpMRtsxgXjs-RPdHYIVm3odE_uvEQ_dTQGFm8oikZMohns34DLBgrQpc"}
+            }`)
+		case "AAAAANuhDN7SjacTnSVsDu3WW1Lvst6dxJGTjRY5BhxPXdf6L6uTcpd_NVtjhn11OUYRsVEykxoUwF-JQU4dn6QylZSKTOJuG0indrdn_MlHpMRtsxgXjs-RPdHYIVm3odE_uvEQ_dTQGFm8oikZMohns34DLBgrQpc":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "0b9c6e5f3a1d4e7f8a2b4c6d8e0f1a3b5c7d9e1f3a5b7c9d1e3f5a7b9c1d3e5f", "hasStoredData": false}],
+              "result_info": {"count": 1, "cursor": ""}
+            }`)
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	objects, err := client.ListDurableObjects(testAccountID, "5fd1cafff895419c8bcc647fc64ab8f0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []DurableObject{
+			{ID: "fe7803fc55b964e09d94666545aab688d360c6bda69ba349ced1e5f28d2fc2c8", HasStoredData: true},
+			{ID: "0b9c6e5f3a1d4e7f8a2b4c6d8e0f1a3b5c7d9e1f3a5b7c9d1e3f5a7b9c1d3e5f"},
+		}, objects)
+	}
+}
+
+func TestListDurableObjectsEmptyNamespaceID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.ListDurableObjects(testAccountID, "")
+	assert.EqualError(t, err, "Durable Object namespace ID cannot be empty")
+}
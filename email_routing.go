@@ -0,0 +1,236 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EmailRoutingSettings describes the Email Routing settings of a zone.
+type EmailRoutingSettings struct {
+	Tag        string     `json:"tag,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	Enabled    bool       `json:"enabled"`
+	Created    *time.Time `json:"created,omitempty"`
+	Modified   *time.Time `json:"modified,omitempty"`
+	SkipWizard bool       `json:"skip_wizard,omitempty"`
+	Status     string     `json:"status,omitempty"`
+}
+
+// EmailRoutingRule describes a rule which routes the emails matching all of
+// its Matchers according to its Actions.
+type EmailRoutingRule struct {
+	Tag      string                    `json:"tag,omitempty"`
+	Name     string                    `json:"name,omitempty"`
+	Priority int                       `json:"priority,omitempty"`
+	Enabled  *bool                     `json:"enabled,omitempty"`
+	Matchers []EmailRoutingRuleMatcher `json:"matchers"`
+	Actions  []EmailRoutingRuleAction  `json:"actions"`
+}
+
+// EmailRoutingRuleMatcher matches emails on a field.
+//
+// Type is either "literal", matching Field against Value, or "all" which
+// matches every email and is used by the catch-all rule.
+type EmailRoutingRuleMatcher struct {
+	Type  string `json:"type"`
+	Field string `json:"field,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// EmailRoutingRuleAction is the action taken on matching emails.
+//
+// Type is one of "forward", "drop" or "worker". Value holds the destination
+// addresses to forward to, or the name of the worker.
+type EmailRoutingRuleAction struct {
+	Type  string   `json:"type"`
+	Value []string `json:"value,omitempty"`
+}
+
+// EmailRoutingDestinationAddress describes an address emails can be forwarded
+// to. Verified is nil until the owner of the address confirmed it.
+type EmailRoutingDestinationAddress struct {
+	Tag      string     `json:"tag,omitempty"`
+	Email    string     `json:"email,omitempty"`
+	Verified *time.Time `json:"verified,omitempty"`
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+}
+
+// EmailRoutingSettingsResponse represents the response from the Email
+// Routing settings endpoints.
+type EmailRoutingSettingsResponse struct {
+	Response
+	Result EmailRoutingSettings `json:"result"`
+}
+
+// EmailRoutingRuleResponse represents the response from the Email Routing
+// rule endpoints containing a single rule.
+type EmailRoutingRuleResponse struct {
+	Response
+	Result EmailRoutingRule `json:"result"`
+}
+
+// ListEmailRoutingRulesResponse represents the response from the list Email
+// Routing rules endpoint.
+type ListEmailRoutingRulesResponse struct {
+	Response
+	Result     []EmailRoutingRule `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// EmailRoutingDestinationAddressResponse represents the response from the
+// destination address endpoints containing a single address.
+type EmailRoutingDestinationAddressResponse struct {
+	Response
+	Result EmailRoutingDestinationAddress `json:"result"`
+}
+
+// ListEmailRoutingDestinationAddressesResponse represents the response from
+// the list destination addresses endpoint.
+type ListEmailRoutingDestinationAddressesResponse struct {
+	Response
+	Result     []EmailRoutingDestinationAddress `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// EmailRoutingSettings returns the Email Routing settings of the given zone.
+//
+// API reference: https://api.cloudflare.com/#email-routing-settings-get-email-routing-settings
+func (api *API) EmailRoutingSettings(zoneID string) (EmailRoutingSettings, error) {
+	uri := "/zones/" + zoneID + "/email/routing"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return EmailRoutingSettings{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r EmailRoutingSettingsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return EmailRoutingSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// EnableEmailRouting enables Email Routing for the given zone, adding the MX
+// and SPF records it requires.
+//
+// API reference: https://api.cloudflare.com/#email-routing-settings-enable-email-routing
+func (api *API) EnableEmailRouting(zoneID string) (EmailRoutingSettings, error) {
+	return api.toggleEmailRouting(zoneID, "enable")
+}
+
+// DisableEmailRouting disables Email Routing for the given zone.
+//
+// API reference: https://api.cloudflare.com/#email-routing-settings-disable-email-routing
+func (api *API) DisableEmailRouting(zoneID string) (EmailRoutingSettings, error) {
+	return api.toggleEmailRouting(zoneID, "disable")
+}
+
+func (api *API) toggleEmailRouting(zoneID, action string) (EmailRoutingSettings, error) {
+	uri := "/zones/" + zoneID + "/email/routing/" + action
+	res, err := api.makeRequest("POST", uri, nil)
+	if err != nil {
+		return EmailRoutingSettings{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r EmailRoutingSettingsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return EmailRoutingSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateEmailRoutingRule creates a routing rule for the given zone.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-create-routing-rule
+func (api *API) CreateEmailRoutingRule(zoneID string, rule EmailRoutingRule) (EmailRoutingRule, error) {
+	uri := "/zones/" + zoneID + "/email/routing/rules"
+	res, err := api.makeRequest("POST", uri, rule)
+	if err != nil {
+		return EmailRoutingRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r EmailRoutingRuleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return EmailRoutingRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListEmailRoutingRules returns all routing rules of the given zone.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-list-routing-rules
+func (api *API) ListEmailRoutingRules(zoneID string) ([]EmailRoutingRule, error) {
+	var rules []EmailRoutingRule
+	uri := "/zones/" + zoneID + "/email/routing/rules"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 50}, 50, func(res []byte) (ResultInfo, error) {
+		var r ListEmailRoutingRulesResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		rules = append(rules, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []EmailRoutingRule{}, err
+	}
+	return rules, nil
+}
+
+// DeleteEmailRoutingRule deletes the given routing rule.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-delete-routing-rule
+func (api *API) DeleteEmailRoutingRule(zoneID, ruleTag string) error {
+	uri := "/zones/" + zoneID + "/email/routing/rules/" + ruleTag
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// CreateEmailRoutingDestinationAddress adds a destination address to the
+// given account. A verification email is sent to the address, which cannot
+// be forwarded to until it has been verified.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-create-a-destination-address
+func (api *API) CreateEmailRoutingDestinationAddress(accountID, email string) (EmailRoutingDestinationAddress, error) {
+	uri := "/accounts/" + accountID + "/email/routing/addresses"
+	res, err := api.makeRequest("POST", uri, EmailRoutingDestinationAddress{Email: email})
+	if err != nil {
+		return EmailRoutingDestinationAddress{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r EmailRoutingDestinationAddressResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return EmailRoutingDestinationAddress{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListEmailRoutingDestinationAddresses returns all destination addresses of
+// the given account, along with their verification state.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-list-destination-addresses
+func (api *API) ListEmailRoutingDestinationAddresses(accountID string) ([]EmailRoutingDestinationAddress, error) {
+	var addresses []EmailRoutingDestinationAddress
+	uri := "/accounts/" + accountID + "/email/routing/addresses"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 50}, 50, func(res []byte) (ResultInfo, error) {
+		var r ListEmailRoutingDestinationAddressesResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		addresses = append(addresses, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []EmailRoutingDestinationAddress{}, err
+	}
+	return addresses, nil
+}
+
+// DeleteEmailRoutingDestinationAddress deletes the given destination address.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-delete-destination-address
+func (api *API) DeleteEmailRoutingDestinationAddress(accountID, addressTag string) error {
+	uri := "/accounts/" + accountID + "/email/routing/addresses/" + addressTag
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
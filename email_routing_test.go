@@ -0,0 +1,191 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailRoutingSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/email/routing", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "tag": "75610dab9e69410a82cf7e400a09ecec",
+            "name": "example.net",
+            "enabled": true,
+            "created": "2014-01-02T02:20:00Z",
+            "modified": "2014-01-02T02:20:00Z",
+            "skip_wizard": true,
+            "status": "ready"
+          }
+        }`)
+	})
+
+	ts, _ := time.Parse(time.RFC3339, "2014-01-02T02:20:00Z")
+	want := EmailRoutingSettings{
+		Tag:        "75610dab9e69410a82cf7e400a09ecec",
+		Name:       "example.net",
+		Enabled:    true,
+		Created:    &ts,
+		Modified:   &ts,
+		SkipWizard: true,
+		Status:     "ready",
+	}
+
+	actual, err := client.EmailRoutingSettings("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateEmailRoutingRule_Forward(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/email/routing/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "Send to user@example.net rule.",
+              "matchers": [
+                {"type": "literal", "field": "to", "value": "test@example.com"}
+              ],
+              "actions": [
+                {"type": "forward", "value": ["destinationaddress@example.net"]}
+              ]
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "tag": "a7e6fb77503c41d8a7f3113c6918f10c",
+            "name": "Send to user@example.net rule.",
+            "priority": 0,
+            "enabled": true,
+            "matchers": [
+              {"type": "literal", "field": "to", "value": "test@example.com"}
+            ],
+            "actions": [
+              {"type": "forward", "value": ["destinationaddress@example.net"]}
+            ]
+          }
+        }`)
+	})
+
+	enabled := true
+	rule := EmailRoutingRule{
+		Name:     "Send to user@example.net rule.",
+		Matchers: []EmailRoutingRuleMatcher{{Type: "literal", Field: "to", Value: "test@example.com"}},
+		Actions:  []EmailRoutingRuleAction{{Type: "forward", Value: []string{"destinationaddress@example.net"}}},
+	}
+	want := rule
+	want.Tag = "a7e6fb77503c41d8a7f3113c6918f10c"
+	want.Enabled = &enabled
+
+	actual, err := client.CreateEmailRoutingRule("023e105f4ecef8ad9ca31a8372d0c353", rule)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListEmailRoutingRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/email/routing/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [
+                {
+                  "tag": "a7e6fb77503c41d8a7f3113c6918f10c",
+                  "name": "Forward rule",
+                  "matchers": [{"type": "literal", "field": "to", "value": "test@example.com"}],
+                  "actions": [{"type": "forward", "value": ["destinationaddress@example.net"]}]
+                }
+              ],
+              "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+            }`)
+		case "2":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [
+                {
+                  "tag": "b8f7gc88614d52e9b8g4224d7a29g21d",
+                  "name": "Catch-all",
+                  "matchers": [{"type": "all"}],
+                  "actions": [{"type": "drop"}]
+                }
+              ],
+              "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+            }`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	actual, err := client.ListEmailRoutingRules("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(actual))
+		assert.Equal(t, "a7e6fb77503c41d8a7f3113c6918f10c", actual[0].Tag)
+		assert.Equal(t, []EmailRoutingRuleMatcher{{Type: "all"}}, actual[1].Matchers)
+		assert.Equal(t, []EmailRoutingRuleAction{{Type: "drop"}}, actual[1].Actions)
+	}
+}
+
+func TestCreateEmailRoutingDestinationAddress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/email/routing/addresses", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"email": "user@example.com"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "tag": "ea95132c15732412d22c1476fa83f27a",
+            "email": "user@example.com",
+            "verified": null,
+            "created": "2014-01-02T02:20:00Z",
+            "modified": "2014-01-02T02:20:00Z"
+          }
+        }`)
+	})
+
+	actual, err := client.CreateEmailRoutingDestinationAddress(testAccountID, "user@example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ea95132c15732412d22c1476fa83f27a", actual.Tag)
+		assert.Nil(t, actual.Verified)
+	}
+}
@@ -1,8 +1,16 @@
 package cloudflare
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // Error messages
 const (
 	errEmptyCredentials     = "invalid credentials: key & email must not be empty"
+	errConflictingCreds     = "invalid credentials: API token cannot be combined with a key, email or user service key"
 	errMakeRequestError     = "error from makeRequest"
 	errUnmarshalError       = "error unmarshalling the JSON response"
 	errRequestNotSuccessful = "error reported by API"
@@ -46,3 +54,105 @@ func (e *UserError) Parse() bool {
 func (e *UserError) Error() string {
 	return e.Err.Error()
 }
+
+// RequestError is returned, possibly wrapped, for a request the API answered
+// with an unsuccessful HTTP status. Use errors.Cause to inspect StatusCode,
+// e.g. to tell a missing resource from other failures.
+type RequestError struct {
+	StatusCode int
+	// Message describes the failure, usually from the errors of the response.
+	Message string
+}
+
+// Error includes the HTTP status with the message.
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("HTTP status %d: %s", e.StatusCode, e.Message)
+}
+
+// ItemError is the failure of a single item of a batched operation.
+type ItemError struct {
+	// ID identifies the item that failed, e.g. a record or hostname ID.
+	ID  string
+	Err error
+}
+
+// Error prefixes the underlying error with the item ID.
+func (e *ItemError) Error() string {
+	return e.ID + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError aggregates the per-item failures of a batched operation.
+//
+// errors.Is and errors.As inspect every contained ItemError, so callers can
+// check for a specific failure without ranging over Errors. This works from
+// Go 1.13 through the Is and As methods; Go 1.20 and later also follow
+// Unwrap.
+type MultiError struct {
+	Errors []*ItemError
+}
+
+// Add records the failure of the item with the given ID. A nil err is
+// ignored.
+func (e *MultiError) Add(id string, err error) {
+	if err == nil {
+		return
+	}
+	e.Errors = append(e.Errors, &ItemError{ID: id, Err: err})
+}
+
+// ErrorOrNil returns e if any failure was recorded and nil otherwise, so a
+// batched helper can end with `return errs.ErrorOrNil()`.
+func (e *MultiError) ErrorOrNil() error {
+	if e == nil || len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error combines the messages of all failures.
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	if len(msgs) == 1 {
+		return "1 error occurred: " + msgs[0]
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(msgs), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the contained item errors.
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Is reports whether any contained item error matches target, for errors.Is
+// on toolchains which do not follow Unwrap() []error.
+func (e *MultiError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first contained item error matching target and sets target
+// to it, for errors.As on toolchains which do not follow Unwrap() []error.
+func (e *MultiError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package cloudflare
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiError_Error(t *testing.T) {
+	var errs MultiError
+	assert.Nil(t, errs.ErrorOrNil())
+
+	errs.Add("a", errors.New("not found"))
+	errs.Add("b", nil)
+	assert.EqualError(t, errs.ErrorOrNil(), "1 error occurred: a: not found")
+
+	errs.Add("c", errors.New("rate limited"))
+	assert.EqualError(t, errs.ErrorOrNil(), "2 errors occurred: a: not found; c: rate limited")
+}
+
+func TestMultiError_Unwrap(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	var errs MultiError
+	errs.Add("a", errors.New("rate limited"))
+	errs.Add("b", errNotFound)
+	errs.Add("c", &UserError{Err: errors.New("invalid zone")})
+	err := errs.ErrorOrNil()
+
+	assert.True(t, errors.Is(err, errNotFound))
+
+	var userErr *UserError
+	if assert.True(t, errors.As(err, &userErr)) {
+		assert.EqualError(t, userErr, "invalid zone")
+	}
+
+	var itemErr *ItemError
+	if assert.True(t, errors.As(err, &itemErr)) {
+		assert.Equal(t, "a", itemErr.ID)
+	}
+}
+
+func TestMultiError_IsAs(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	var errs MultiError
+	errs.Add("a", errors.New("rate limited"))
+	errs.Add("b", errNotFound)
+	errs.Add("c", &UserError{Err: errors.New("invalid zone")})
+
+	// Called directly, as errors.Is and errors.As do before Go 1.20.
+	assert.True(t, errs.Is(errNotFound))
+	assert.False(t, errs.Is(errors.New("not found")))
+
+	var userErr *UserError
+	if assert.True(t, errs.As(&userErr)) {
+		assert.EqualError(t, userErr, "invalid zone")
+	}
+	var reqErr *RequestError
+	assert.False(t, errs.As(&reqErr))
+}
@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"net/url"
 	"strconv"
 	"time"
@@ -186,7 +185,7 @@ func (api *API) listAccessRules(prefix string, accessRule AccessRule, page int)
 	}
 
 	response := &AccessRuleListResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -201,7 +200,7 @@ func (api *API) createAccessRule(prefix string, accessRule AccessRule) (*AccessR
 	}
 
 	response := &AccessRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -217,7 +216,7 @@ func (api *API) updateAccessRule(prefix, accessRuleID string, accessRule AccessR
 	}
 
 	response := &AccessRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -232,7 +231,7 @@ func (api *API) deleteAccessRule(prefix, accessRuleID string) (*AccessRuleRespon
 	}
 
 	response := &AccessRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -0,0 +1,133 @@
+package cloudflare
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// firewallEventsQuery selects the firewall events of a zone, newest first.
+const firewallEventsQuery = `query FirewallEvents($zoneTag: string, $filter: FirewallEventsAdaptiveFilter_InputObject, $limit: uint64) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      firewallEventsAdaptive(filter: $filter, limit: $limit, orderBy: [datetime_DESC]) {
+        action
+        source
+        clientIP
+        clientCountryName
+        clientASNDescription
+        clientRequestHTTPHost
+        clientRequestHTTPMethodName
+        clientRequestPath
+        clientRequestQuery
+        userAgent
+        rayName
+        ruleId
+        datetime
+      }
+    }
+  }
+}`
+
+// defaultFirewallEventsLimit is the number of events returned when
+// FirewallEventsFilter.Limit is unset.
+const defaultFirewallEventsLimit = 100
+
+// FirewallEventsFilter selects the firewall events returned by
+// FirewallEvents. Since is required; the other fields are optional and left
+// out of the query when empty.
+type FirewallEventsFilter struct {
+	Since time.Time
+	Until time.Time
+	// Action is e.g. "block", "challenge", "jschallenge" or "log".
+	Action string
+	// Source is the product which acted on the request, e.g. "waf",
+	// "firewallrules", "ratelimit" or "securitylevel".
+	Source   string
+	ClientIP string
+	RayID    string
+	// Limit caps the number of events returned, defaulting to 100.
+	Limit int
+}
+
+// FirewallEvent is a single request acted on by one of the security products
+// of a zone.
+type FirewallEvent struct {
+	Action            string    `json:"action"`
+	Source            string    `json:"source"`
+	ClientIP          string    `json:"clientIP"`
+	ClientCountryName string    `json:"clientCountryName"`
+	ClientASN         string    `json:"clientASNDescription"`
+	Host              string    `json:"clientRequestHTTPHost"`
+	Method            string    `json:"clientRequestHTTPMethodName"`
+	Path              string    `json:"clientRequestPath"`
+	Query             string    `json:"clientRequestQuery"`
+	UserAgent         string    `json:"userAgent"`
+	RayID             string    `json:"rayName"`
+	RuleID            string    `json:"ruleId"`
+	Datetime          time.Time `json:"datetime"`
+}
+
+// variables returns the GraphQL filter variable for f.
+func (f FirewallEventsFilter) variables() map[string]interface{} {
+	filter := map[string]interface{}{
+		"datetime_geq": f.Since.UTC().Format(time.RFC3339),
+	}
+	if !f.Until.IsZero() {
+		filter["datetime_leq"] = f.Until.UTC().Format(time.RFC3339)
+	}
+	if f.Action != "" {
+		filter["action"] = f.Action
+	}
+	if f.Source != "" {
+		filter["source"] = f.Source
+	}
+	if f.ClientIP != "" {
+		filter["clientIP"] = f.ClientIP
+	}
+	if f.RayID != "" {
+		filter["rayName"] = f.RayID
+	}
+	return filter
+}
+
+// FirewallEvents returns the most recent firewall events of the given zone
+// matching opts, newest first, using the GraphQL Analytics API.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/tutorials/querying-firewall-events/
+func (api *API) FirewallEvents(zoneID string, opts FirewallEventsFilter) ([]FirewallEvent, error) {
+	if opts.Since.IsZero() {
+		return nil, errors.New("firewall events filter requires a start time")
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultFirewallEventsLimit
+	}
+
+	data, err := api.GraphQL(context.TODO(), firewallEventsQuery, map[string]interface{}{
+		"zoneTag": zoneID,
+		"filter":  opts.variables(),
+		"limit":   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Viewer struct {
+			Zones []struct {
+				FirewallEventsAdaptive []FirewallEvent `json:"firewallEventsAdaptive"`
+			} `json:"zones"`
+		} `json:"viewer"`
+	}
+	if err := api.unmarshal(data, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+
+	var events []FirewallEvent
+	for _, zone := range r.Viewer.Zones {
+		events = append(events, zone.FirewallEventsAdaptive...)
+	}
+	return events, nil
+}
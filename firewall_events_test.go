@@ -0,0 +1,141 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirewallEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body graphQLRequest
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			assert.Equal(t, firewallEventsQuery, body.Query)
+			b, _ := json.Marshal(body.Variables)
+			assert.JSONEq(t, `{
+              "zoneTag": "023e105f4ecef8ad9ca31a8372d0c353",
+              "limit": 10,
+              "filter": {
+                "datetime_geq": "2021-06-01T10:00:00Z",
+                "datetime_leq": "2021-06-01T11:00:00Z",
+                "action": "block",
+                "source": "firewallrules",
+                "clientIP": "203.0.113.7"
+              }
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "data": {
+            "viewer": {
+              "zones": [
+                {
+                  "firewallEventsAdaptive": [
+                    {
+                      "action": "block",
+                      "source": "firewallrules",
+                      "clientIP": "203.0.113.7",
+                      "clientCountryName": "NL",
+                      "clientASNDescription": "EXAMPLE-AS",
+                      "clientRequestHTTPHost": "www.example.com",
+                      "clientRequestHTTPMethodName": "POST",
+                      "clientRequestPath": "/wp-login.php",
+                      "clientRequestQuery": "",
+                      "userAgent": "curl/7.68.0",
+                      "rayName": "65a5b6a4ef0e2b2f",
+                      "ruleId": "372e67954025e0ba6aaa6d586b9e0b59",
+                      "datetime": "2021-06-01T10:42:17Z"
+                    },
+                    {
+                      "action": "block",
+                      "source": "firewallrules",
+                      "clientIP": "203.0.113.7",
+                      "clientRequestPath": "/xmlrpc.php",
+                      "rayName": "65a5b5f01b0c2b2f",
+                      "ruleId": "372e67954025e0ba6aaa6d586b9e0b59",
+                      "datetime": "2021-06-01T10:41:59Z"
+                    }
+                  ]
+                }
+              ]
+            }
+          },
+          "errors": null
+        }`)
+	})
+
+	since, _ := time.Parse(time.RFC3339, "2021-06-01T10:00:00Z")
+	first, _ := time.Parse(time.RFC3339, "2021-06-01T10:42:17Z")
+	events, err := client.FirewallEvents("023e105f4ecef8ad9ca31a8372d0c353", FirewallEventsFilter{
+		Since:    since,
+		Until:    since.Add(time.Hour),
+		Action:   "block",
+		Source:   "firewallrules",
+		ClientIP: "203.0.113.7",
+		Limit:    10,
+	})
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(events)) {
+		assert.Equal(t, FirewallEvent{
+			Action:            "block",
+			Source:            "firewallrules",
+			ClientIP:          "203.0.113.7",
+			ClientCountryName: "NL",
+			ClientASN:         "EXAMPLE-AS",
+			Host:              "www.example.com",
+			Method:            "POST",
+			Path:              "/wp-login.php",
+			UserAgent:         "curl/7.68.0",
+			RayID:             "65a5b6a4ef0e2b2f",
+			RuleID:            "372e67954025e0ba6aaa6d586b9e0b59",
+			Datetime:          first,
+		}, events[0])
+		assert.Equal(t, "/xmlrpc.php", events[1].Path)
+	}
+}
+
+func TestFirewallEvents_DefaultsAndValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body graphQLRequest
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			assert.Equal(t, float64(defaultFirewallEventsLimit), body.Variables["limit"])
+			assert.Equal(t, map[string]interface{}{"datetime_geq": "2021-06-01T10:00:00Z", "rayName": "65a5b6a4ef0e2b2f"}, body.Variables["filter"])
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"data": {"viewer": {"zones": [{"firewallEventsAdaptive": []}]}}, "errors": null}`)
+	})
+
+	_, err := client.FirewallEvents("023e105f4ecef8ad9ca31a8372d0c353", FirewallEventsFilter{})
+	assert.Error(t, err)
+
+	since, _ := time.Parse(time.RFC3339, "2021-06-01T10:00:00Z")
+	events, err := client.FirewallEvents("023e105f4ecef8ad9ca31a8372d0c353", FirewallEventsFilter{Since: since, RayID: "65a5b6a4ef0e2b2f"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, events)
+	}
+}
+
+func TestFirewallEvents_StrictJSON(t *testing.T) {
+	setup(UsingStrictJSON(true))
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"data": {"viewer": {"zones": [{"firewallEventsAdaptive": [], "firewallEventsAdaptiveGroups": []}]}}, "errors": null}`)
+	})
+
+	since, _ := time.Parse(time.RFC3339, "2021-06-01T10:00:00Z")
+	_, err := client.FirewallEvents("023e105f4ecef8ad9ca31a8372d0c353", FirewallEventsFilter{Since: since})
+	assert.Error(t, err)
+}
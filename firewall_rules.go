@@ -0,0 +1,193 @@
+package cloudflare
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Firewall rule actions.
+const (
+	FirewallRuleActionBlock       = "block"
+	FirewallRuleActionChallenge   = "challenge"
+	FirewallRuleActionJSChallenge = "js_challenge"
+	FirewallRuleActionAllow       = "allow"
+	FirewallRuleActionLog         = "log"
+	FirewallRuleActionBypass      = "bypass"
+)
+
+// Security products a bypass firewall rule can skip.
+const (
+	FirewallRuleProductZoneLockdown  = "zoneLockdown"
+	FirewallRuleProductUABlock       = "uaBlock"
+	FirewallRuleProductBIC           = "bic"
+	FirewallRuleProductHotlink       = "hot"
+	FirewallRuleProductSecurityLevel = "securityLevel"
+	FirewallRuleProductRateLimit     = "rateLimit"
+	FirewallRuleProductWAF           = "waf"
+)
+
+var firewallRuleProducts = []string{
+	FirewallRuleProductZoneLockdown,
+	FirewallRuleProductUABlock,
+	FirewallRuleProductBIC,
+	FirewallRuleProductHotlink,
+	FirewallRuleProductSecurityLevel,
+	FirewallRuleProductRateLimit,
+	FirewallRuleProductWAF,
+}
+
+// FirewallRule describes a firewall rule applying Action to the requests
+// matched by its filter. Products lists the security products skipped by a
+// bypass rule and must be empty for other actions.
+type FirewallRule struct {
+	ID          string             `json:"id,omitempty"`
+	Paused      bool               `json:"paused"`
+	Description string             `json:"description,omitempty"`
+	Action      string             `json:"action"`
+	Priority    int                `json:"priority,omitempty"`
+	Filter      FirewallRuleFilter `json:"filter"`
+	Products    []string           `json:"products,omitempty"`
+	Ref         string             `json:"ref,omitempty"`
+	CreatedOn   *time.Time         `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time         `json:"modified_on,omitempty"`
+}
+
+// FirewallRuleFilter is the filter of a firewall rule. An existing filter is
+// referenced by ID alone, while a new one is created from Expression.
+type FirewallRuleFilter struct {
+	ID          string `json:"id,omitempty"`
+	Expression  string `json:"expression,omitempty"`
+	Paused      bool   `json:"paused"`
+	Description string `json:"description,omitempty"`
+	Ref         string `json:"ref,omitempty"`
+}
+
+// FirewallRuleResponse represents the response from the firewall rule
+// endpoints containing a single rule.
+type FirewallRuleResponse struct {
+	Response
+	Result FirewallRule `json:"result"`
+}
+
+// FirewallRulesResponse represents the response from the firewall rule
+// endpoints containing several rules.
+type FirewallRulesResponse struct {
+	Response
+	Result     []FirewallRule `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// validate reports products which are unknown or set on a rule whose action
+// is not bypass.
+func (rule FirewallRule) validate() error {
+	if len(rule.Products) == 0 {
+		return nil
+	}
+	if rule.Action != FirewallRuleActionBypass {
+		return errors.Errorf("invalid firewall rule: products can only be set on %s rules, not %s", FirewallRuleActionBypass, rule.Action)
+	}
+	for _, p := range rule.Products {
+		if !containsString(firewallRuleProducts, p) {
+			return errors.Errorf("invalid firewall rule product %q: must be one of %s", p, strings.Join(firewallRuleProducts, ", "))
+		}
+	}
+	return nil
+}
+
+// FirewallRules returns all firewall rules of the given zone.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-list-of-firewall-rules
+func (api *API) FirewallRules(zoneID string) ([]FirewallRule, error) {
+	var rules []FirewallRule
+	uri := "/zones/" + zoneID + "/firewall/rules"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 100}, 100, func(res []byte) (ResultInfo, error) {
+		var r FirewallRulesResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		rules = append(rules, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []FirewallRule{}, err
+	}
+	return rules, nil
+}
+
+// FirewallRule returns a single firewall rule.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-get-individual-firewall-rule
+func (api *API) FirewallRule(zoneID, ruleID string) (FirewallRule, error) {
+	uri := "/zones/" + zoneID + "/firewall/rules/" + ruleID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return FirewallRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r FirewallRuleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return FirewallRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateFirewallRules creates the given firewall rules, along with any filter
+// given by expression.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-create-firewall-rules
+func (api *API) CreateFirewallRules(zoneID string, rules []FirewallRule) ([]FirewallRule, error) {
+	for _, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return []FirewallRule{}, err
+		}
+	}
+
+	uri := "/zones/" + zoneID + "/firewall/rules"
+	res, err := api.makeRequest("POST", uri, rules)
+	if err != nil {
+		return []FirewallRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r FirewallRulesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []FirewallRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateFirewallRule replaces the firewall rule identified by rule.ID.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-update-individual-firewall-rule
+func (api *API) UpdateFirewallRule(zoneID string, rule FirewallRule) (FirewallRule, error) {
+	if rule.ID == "" {
+		return FirewallRule{}, errors.New("firewall rule ID cannot be empty")
+	}
+	if err := rule.validate(); err != nil {
+		return FirewallRule{}, err
+	}
+
+	uri := "/zones/" + zoneID + "/firewall/rules/" + rule.ID
+	res, err := api.makeRequest("PUT", uri, rule)
+	if err != nil {
+		return FirewallRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r FirewallRuleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return FirewallRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteFirewallRule deletes a single firewall rule. Its filter is kept.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-delete-individual-firewall-rule
+func (api *API) DeleteFirewallRule(zoneID, ruleID string) error {
+	if ruleID == "" {
+		return errors.New("firewall rule ID cannot be empty")
+	}
+	uri := "/zones/" + zoneID + "/firewall/rules/" + ruleID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
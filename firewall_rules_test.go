@@ -0,0 +1,191 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFirewallRules_Bypass(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/firewall/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[
+              {
+                "paused": false,
+                "description": "Trusted partners",
+                "action": "bypass",
+                "filter": {"expression": "ip.src in {192.0.2.0/24}", "paused": false},
+                "products": ["waf", "uaBlock"]
+              }
+            ]`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "372e67954025e0ba6aaa6d586b9e0b60",
+              "paused": false,
+              "description": "Trusted partners",
+              "action": "bypass",
+              "priority": 10,
+              "filter": {
+                "id": "bd6e646a8e1ea3d0bcbb57d84a4021b4",
+                "expression": "ip.src in {192.0.2.0/24}",
+                "paused": false
+              },
+              "products": ["waf", "uaBlock"]
+            }
+          ]
+        }`)
+	})
+
+	want := []FirewallRule{{
+		ID:          "372e67954025e0ba6aaa6d586b9e0b60",
+		Description: "Trusted partners",
+		Action:      FirewallRuleActionBypass,
+		Priority:    10,
+		Filter: FirewallRuleFilter{
+			ID:         "bd6e646a8e1ea3d0bcbb57d84a4021b4",
+			Expression: "ip.src in {192.0.2.0/24}",
+		},
+		Products: []string{FirewallRuleProductWAF, FirewallRuleProductUABlock},
+	}}
+
+	actual, err := client.CreateFirewallRules("023e105f4ecef8ad9ca31a8372d0c353", []FirewallRule{{
+		Description: "Trusted partners",
+		Action:      FirewallRuleActionBypass,
+		Filter:      FirewallRuleFilter{Expression: "ip.src in {192.0.2.0/24}"},
+		Products:    []string{FirewallRuleProductWAF, FirewallRuleProductUABlock},
+	}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateFirewallRules_InvalidProducts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.CreateFirewallRules("023e105f4ecef8ad9ca31a8372d0c353", []FirewallRule{{
+		Action:   FirewallRuleActionBlock,
+		Filter:   FirewallRuleFilter{Expression: "ip.src eq 192.0.2.1"},
+		Products: []string{FirewallRuleProductWAF},
+	}})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "products can only be set on bypass rules")
+	}
+
+	_, err = client.CreateFirewallRules("023e105f4ecef8ad9ca31a8372d0c353", []FirewallRule{{
+		Action:   FirewallRuleActionBypass,
+		Filter:   FirewallRuleFilter{Expression: "ip.src eq 192.0.2.1"},
+		Products: []string{"captcha"},
+	}})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `invalid firewall rule product "captcha"`)
+	}
+}
+
+func TestFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/firewall/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "372e67954025e0ba6aaa6d586b9e0b60", "action": "block", "filter": {"id": "bd6e646a8e1ea3d0bcbb57d84a4021b4"}}],
+              "result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+            }`)
+			return
+		}
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [{"id": "f2d427378e7542acb295380d352e2ebd", "action": "bypass", "products": ["rateLimit"], "filter": {"id": "a8d3f1b9c1e24c4b8e0f7c52d4a3b6e1"}}],
+          "result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+        }`)
+	})
+
+	rules, err := client.FirewallRules("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(rules)) {
+		assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b60", rules[0].ID)
+		assert.Equal(t, []string{FirewallRuleProductRateLimit}, rules[1].Products)
+	}
+}
+
+func TestUpdateFirewallRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/firewall/rules/372e67954025e0ba6aaa6d586b9e0b60", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "id": "372e67954025e0ba6aaa6d586b9e0b60",
+              "paused": true,
+              "action": "bypass",
+              "filter": {"id": "bd6e646a8e1ea3d0bcbb57d84a4021b4", "paused": false},
+              "products": ["securityLevel"]
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "372e67954025e0ba6aaa6d586b9e0b60",
+            "paused": true,
+            "action": "bypass",
+            "filter": {"id": "bd6e646a8e1ea3d0bcbb57d84a4021b4"},
+            "products": ["securityLevel"]
+          }
+        }`)
+	})
+
+	rule, err := client.UpdateFirewallRule("023e105f4ecef8ad9ca31a8372d0c353", FirewallRule{
+		ID:       "372e67954025e0ba6aaa6d586b9e0b60",
+		Paused:   true,
+		Action:   FirewallRuleActionBypass,
+		Filter:   FirewallRuleFilter{ID: "bd6e646a8e1ea3d0bcbb57d84a4021b4"},
+		Products: []string{FirewallRuleProductSecurityLevel},
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, rule.Paused)
+	}
+
+	_, err = client.UpdateFirewallRule("023e105f4ecef8ad9ca31a8372d0c353", FirewallRule{})
+	assert.Error(t, err)
+}
+
+func TestDeleteFirewallRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/firewall/rules/372e67954025e0ba6aaa6d586b9e0b60", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "372e67954025e0ba6aaa6d586b9e0b60"}}`)
+	})
+
+	assert.NoError(t, client.DeleteFirewallRule("023e105f4ecef8ad9ca31a8372d0c353", "372e67954025e0ba6aaa6d586b9e0b60"))
+}
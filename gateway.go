@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Gateway rule actions.
+const (
+	GatewayRuleActionAllow      = "allow"
+	GatewayRuleActionBlock      = "block"
+	GatewayRuleActionSafeSearch = "safesearch"
+	GatewayRuleActionIsolate    = "isolate"
+	GatewayRuleActionNoScan     = "noscan"
+	GatewayRuleActionOff        = "off"
+	GatewayRuleActionOn         = "on"
+)
+
+// Gateway rule filters, i.e. the kind of traffic a rule applies to.
+const (
+	GatewayRuleFilterDNS  = "dns"
+	GatewayRuleFilterHTTP = "http"
+	GatewayRuleFilterL4   = "l4"
+)
+
+// GatewayRule describes a Secure Web Gateway rule. Traffic is a wirefilter
+// expression the traffic selected by Filters is matched against; matching
+// rules with the lowest Precedence are evaluated first.
+type GatewayRule struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Precedence  int        `json:"precedence"`
+	Enabled     bool       `json:"enabled"`
+	Action      string     `json:"action"`
+	Filters     []string   `json:"filters"`
+	Traffic     string     `json:"traffic"`
+	Identity    string     `json:"identity,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// GatewayLocation describes a network from which DNS queries are attributed
+// to the account by Gateway.
+type GatewayLocation struct {
+	ID            string                   `json:"id,omitempty"`
+	Name          string                   `json:"name"`
+	ClientDefault bool                     `json:"client_default"`
+	Networks      []GatewayLocationNetwork `json:"networks,omitempty"`
+	DOHSubdomain  string                   `json:"doh_subdomain,omitempty"`
+	IP            string                   `json:"ip,omitempty"`
+	ECSSupport    bool                     `json:"ecs_support"`
+	CreatedAt     *time.Time               `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time               `json:"updated_at,omitempty"`
+}
+
+// GatewayLocationNetwork is an IPv4 network of a location in CIDR notation.
+type GatewayLocationNetwork struct {
+	Network string `json:"network"`
+}
+
+// GatewayRuleResponse represents the response from the Gateway rule
+// endpoints containing a single rule.
+type GatewayRuleResponse struct {
+	Response
+	Result GatewayRule `json:"result"`
+}
+
+// GatewayRulesResponse represents the response from the list Gateway rules
+// endpoint.
+type GatewayRulesResponse struct {
+	Response
+	Result []GatewayRule `json:"result"`
+}
+
+// GatewayLocationsResponse represents the response from the list Gateway
+// locations endpoint.
+type GatewayLocationsResponse struct {
+	Response
+	Result []GatewayLocation `json:"result"`
+}
+
+// CreateGatewayRule creates a Gateway rule in the given account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-create-zero-trust-gateway-rule
+func (api *API) CreateGatewayRule(accountID string, rule GatewayRule) (GatewayRule, error) {
+	uri := "/accounts/" + accountID + "/gateway/rules"
+	res, err := api.makeRequest("POST", uri, rule)
+	if err != nil {
+		return GatewayRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r GatewayRuleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return GatewayRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// GatewayRules returns the Gateway rules of the given account, ordered by
+// precedence.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-list-zero-trust-gateway-rules
+func (api *API) GatewayRules(accountID string) ([]GatewayRule, error) {
+	uri := "/accounts/" + accountID + "/gateway/rules"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []GatewayRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r GatewayRulesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []GatewayRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	sort.SliceStable(r.Result, func(i, j int) bool {
+		return r.Result[i].Precedence < r.Result[j].Precedence
+	})
+	return r.Result, nil
+}
+
+// UpdateGatewayRule replaces the given Gateway rule. The rule's ID must be
+// set.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-update-a-zero-trust-gateway-rule
+func (api *API) UpdateGatewayRule(accountID string, rule GatewayRule) (GatewayRule, error) {
+	if rule.ID == "" {
+		return GatewayRule{}, errors.New("rule ID cannot be empty")
+	}
+	uri := "/accounts/" + accountID + "/gateway/rules/" + rule.ID
+	res, err := api.makeRequest("PUT", uri, rule)
+	if err != nil {
+		return GatewayRule{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r GatewayRuleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return GatewayRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteGatewayRule deletes the given Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-delete-a-zero-trust-gateway-rule
+func (api *API) DeleteGatewayRule(accountID, ruleID string) error {
+	uri := "/accounts/" + accountID + "/gateway/rules/" + ruleID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// GatewayLocations returns the Gateway locations of the given account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-locations-list-zero-trust-gateway-locations
+func (api *API) GatewayLocations(accountID string) ([]GatewayLocation, error) {
+	uri := "/accounts/" + accountID + "/gateway/locations"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []GatewayLocation{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r GatewayLocationsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []GatewayLocation{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
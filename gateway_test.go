@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGatewayRule_DNSBlock(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "block bad websites",
+              "precedence": 1000,
+              "enabled": true,
+              "action": "block",
+              "filters": ["dns"],
+              "traffic": "any(dns.domains[*] == \"example.com\")"
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+            "name": "block bad websites",
+            "precedence": 1000,
+            "enabled": true,
+            "action": "block",
+            "filters": ["dns"],
+            "traffic": "any(dns.domains[*] == \"example.com\")",
+            "created_at": "2014-01-01T05:20:00.12345Z",
+            "updated_at": "2014-01-01T05:20:00.12345Z"
+          }
+        }`)
+	})
+
+	rule, err := client.CreateGatewayRule(testAccountID, GatewayRule{
+		Name:       "block bad websites",
+		Precedence: 1000,
+		Enabled:    true,
+		Action:     GatewayRuleActionBlock,
+		Filters:    []string{GatewayRuleFilterDNS},
+		Traffic:    `any(dns.domains[*] == "example.com")`,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", rule.ID)
+		assert.Equal(t, GatewayRuleActionBlock, rule.Action)
+		assert.NotNil(t, rule.CreatedAt)
+	}
+}
+
+func TestGatewayRules_OrderedByPrecedence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {"id": "c", "name": "allow all", "precedence": 3000, "action": "allow", "filters": ["dns"], "traffic": ""},
+            {"id": "a", "name": "block malware", "precedence": 1000, "action": "block", "filters": ["dns"], "traffic": "any(dns.security_category[*] in {80})"},
+            {"id": "b", "name": "isolate news", "precedence": 2000, "action": "isolate", "filters": ["http"], "traffic": "any(http.request.uri.content_category[*] in {1})"}
+          ]
+        }`)
+	})
+
+	rules, err := client.GatewayRules(testAccountID)
+	if assert.NoError(t, err) {
+		var ids []string
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, ids)
+	}
+}
@@ -0,0 +1,57 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GraphQLError describes a single error returned by the GraphQL Analytics API.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// graphQLRequest is the body posted to the GraphQL endpoint.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLResponse represents the response from the GraphQL endpoint. Unlike
+// the REST endpoints it does not use the common Response envelope.
+type GraphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors"`
+}
+
+// GraphQL executes a query against the GraphQL Analytics API and returns the
+// untouched "data" member of the response, leaving callers free to decode it
+// into whatever shape their query selects.
+//
+// An error is returned if the API reports any errors, even when partial data
+// is available.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GraphQL(ctx context.Context, query string, variables map[string]interface{}) (json.RawMessage, error) {
+	res, err := api.makeRequestContext(ctx, "POST", "/graphql", graphQLRequest{query, variables})
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r GraphQLResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	if len(r.Errors) > 0 {
+		msgs := make([]string, len(r.Errors))
+		for i, e := range r.Errors {
+			msgs[i] = e.Message
+		}
+		return nil, errors.Errorf("graphql query failed: %s", strings.Join(msgs, "; "))
+	}
+	return r.Data, nil
+}
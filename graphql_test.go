@@ -0,0 +1,69 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const query = `query ($zoneTag: string) { viewer { zones(filter: {zoneTag: $zoneTag}) { httpRequests1dGroups(limit: 1) { sum { requests } } } } }`
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "cloudflare@example.org", r.Header.Get("X-Auth-Email"))
+		assert.Equal(t, "deadbeef", r.Header.Get("X-Auth-Key"))
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, fmt.Sprintf(`{
+              "query": %q,
+              "variables": {"zoneTag": "023e105f4ecef8ad9ca31a8372d0c353"}
+            }`, query), string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "data": {
+            "viewer": {
+              "zones": [
+                {"httpRequests1dGroups": [{"sum": {"requests": 1234}}]}
+              ]
+            }
+          },
+          "errors": null
+        }`)
+	})
+
+	data, err := client.GraphQL(context.Background(), query, map[string]interface{}{
+		"zoneTag": "023e105f4ecef8ad9ca31a8372d0c353",
+	})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"viewer":{"zones":[{"httpRequests1dGroups":[{"sum":{"requests":1234}}]}]}}`, string(data))
+	}
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "data": null,
+          "errors": [
+            {"message": "unknown field \"foo\"", "path": ["viewer", "foo"]}
+          ]
+        }`)
+	})
+
+	_, err := client.GraphQL(context.Background(), "{ viewer { foo } }", nil)
+	assert.EqualError(t, err, `graphql query failed: unknown field "foo"`)
+}
@@ -0,0 +1,263 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Image describes an image stored on Cloudflare Images. Variants holds the
+// delivery URL of every variant of the image.
+type Image struct {
+	ID                string                 `json:"id"`
+	Filename          string                 `json:"filename"`
+	Metadata          map[string]interface{} `json:"meta,omitempty"`
+	RequireSignedURLs bool                   `json:"requireSignedURLs"`
+	Variants          []string               `json:"variants"`
+	Uploaded          time.Time              `json:"uploaded"`
+}
+
+// ImagesVariant describes how images are resized when delivered through a
+// named variant.
+type ImagesVariant struct {
+	ID                     string               `json:"id"`
+	NeverRequireSignedURLs bool                 `json:"neverRequireSignedURLs"`
+	Options                ImagesVariantOptions `json:"options"`
+}
+
+// ImagesVariantOptions holds the resizing options of a variant. Fit is one of
+// "scale-down", "contain", "cover", "crop" or "pad", and Metadata one of
+// "keep", "copyright" or "none".
+type ImagesVariantOptions struct {
+	Fit      string `json:"fit,omitempty"`
+	Height   int    `json:"height,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	Metadata string `json:"metadata,omitempty"`
+}
+
+// ImageDetailsResponse represents the response from the Images endpoints
+// containing a single image.
+type ImageDetailsResponse struct {
+	Response
+	Result Image `json:"result"`
+}
+
+// ImagesListResponse represents the response from the list Images endpoint.
+type ImagesListResponse struct {
+	Response
+	Result struct {
+		Images []Image `json:"images"`
+	} `json:"result"`
+}
+
+// ImagesVariantResponse represents the response from the Images variant
+// endpoints containing a single variant.
+type ImagesVariantResponse struct {
+	Response
+	Result struct {
+		Variant ImagesVariant `json:"variant"`
+	} `json:"result"`
+}
+
+// ImagesVariantsListResponse represents the response from the list Images
+// variants endpoint.
+type ImagesVariantsListResponse struct {
+	Response
+	Result struct {
+		Variants map[string]ImagesVariant `json:"variants"`
+	} `json:"result"`
+}
+
+// UploadImage uploads the image read from file to the given account. If file
+// has a Name method, such as an *os.File, its base name is used as the
+// filename. metadata is stored alongside the image and may be nil.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-upload-an-image-using-a-single-http-request
+func (api *API) UploadImage(accountID string, file io.Reader, metadata map[string]string) (Image, error) {
+	filename := "image"
+	if f, ok := file.(interface {
+		Name() string
+	}); ok {
+		filename = filepath.Base(f.Name())
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return Image{}, errors.Wrap(err, "error creating multipart form")
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return Image{}, errors.Wrap(err, "error reading image")
+	}
+	if len(metadata) > 0 {
+		meta, err := json.Marshal(metadata)
+		if err != nil {
+			return Image{}, errors.Wrap(err, "error marshalling metadata to JSON")
+		}
+		if err := w.WriteField("metadata", string(meta)); err != nil {
+			return Image{}, errors.Wrap(err, "error creating multipart form")
+		}
+	}
+	if err := w.Close(); err != nil {
+		return Image{}, errors.Wrap(err, "error creating multipart form")
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", w.FormDataContentType())
+	uri := "/accounts/" + accountID + "/images/v1"
+	res, err := api.makeRequestWithHeaders("POST", uri, body.Bytes(), headers)
+	if err != nil {
+		return Image{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ImageDetailsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Image{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListImages lists all images of the given account.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-list-images
+func (api *API) ListImages(accountID string) ([]Image, error) {
+	var images []Image
+	uri := "/accounts/" + accountID + "/images/v1"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 100}, 100, func(res []byte) (ResultInfo, error) {
+		var r ImagesListResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		images = append(images, r.Result.Images...)
+		// No result_info is returned, so the last page is the first short one.
+		return ResultInfo{Count: len(r.Result.Images)}, nil
+	})
+	if err != nil {
+		return []Image{}, err
+	}
+	return images, nil
+}
+
+// ImageDetails returns the details of a single image.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-image-details
+func (api *API) ImageDetails(accountID, imageID string) (Image, error) {
+	uri := "/accounts/" + accountID + "/images/v1/" + imageID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return Image{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ImageDetailsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Image{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteImage deletes an image and all of its variants.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-delete-image
+func (api *API) DeleteImage(accountID, imageID string) error {
+	uri := "/accounts/" + accountID + "/images/v1/" + imageID
+	_, err := api.makeRequest("DELETE", uri, nil)
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// CreateImagesVariant creates a new variant in the given account.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-create-a-variant
+func (api *API) CreateImagesVariant(accountID string, variant ImagesVariant) (ImagesVariant, error) {
+	uri := "/accounts/" + accountID + "/images/v1/variants"
+	res, err := api.makeRequest("POST", uri, variant)
+	if err != nil {
+		return ImagesVariant{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ImagesVariantResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ImagesVariant{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Variant, nil
+}
+
+// ListImagesVariants lists the variants of the given account, sorted by ID.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-list-variants
+func (api *API) ListImagesVariants(accountID string) ([]ImagesVariant, error) {
+	uri := "/accounts/" + accountID + "/images/v1/variants"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []ImagesVariant{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ImagesVariantsListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []ImagesVariant{}, errors.Wrap(err, errUnmarshalError)
+	}
+	variants := make([]ImagesVariant, 0, len(r.Result.Variants))
+	for _, v := range r.Result.Variants {
+		variants = append(variants, v)
+	}
+	sort.Slice(variants, func(i, j int) bool { return variants[i].ID < variants[j].ID })
+	return variants, nil
+}
+
+// ImagesVariant returns the details of a single variant.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-variant-details
+func (api *API) ImagesVariant(accountID, variantID string) (ImagesVariant, error) {
+	uri := "/accounts/" + accountID + "/images/v1/variants/" + variantID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ImagesVariant{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ImagesVariantResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ImagesVariant{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Variant, nil
+}
+
+// UpdateImagesVariant changes the options of an existing variant, identified
+// by variant.ID.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-update-a-variant
+func (api *API) UpdateImagesVariant(accountID string, variant ImagesVariant) (ImagesVariant, error) {
+	if variant.ID == "" {
+		return ImagesVariant{}, errors.New("variant ID cannot be empty")
+	}
+	uri := "/accounts/" + accountID + "/images/v1/variants/" + variant.ID
+	res, err := api.makeRequest("PATCH", uri, struct {
+		NeverRequireSignedURLs bool                 `json:"neverRequireSignedURLs"`
+		Options                ImagesVariantOptions `json:"options"`
+	}{variant.NeverRequireSignedURLs, variant.Options})
+	if err != nil {
+		return ImagesVariant{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ImagesVariantResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ImagesVariant{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Variant, nil
+}
+
+// DeleteImagesVariant deletes a variant. Images are no longer served through
+// it afterwards.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-delete-a-variant
+func (api *API) DeleteImagesVariant(accountID, variantID string) error {
+	uri := "/accounts/" + accountID + "/images/v1/variants/" + variantID
+	_, err := api.makeRequest("DELETE", uri, nil)
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
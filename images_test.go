@@ -0,0 +1,225 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadImage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"), "Expected multipart content type, got %s", r.Header.Get("Content-Type"))
+
+		f, h, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			defer f.Close()
+			assert.Equal(t, "image", h.Filename)
+			b, err := ioutil.ReadAll(f)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "fake png bytes", string(b))
+			}
+		}
+		assert.JSONEq(t, `{"key": "value"}`, r.FormValue("metadata"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B",
+            "filename": "image",
+            "meta": {"key": "value"},
+            "requireSignedURLs": false,
+            "variants": [
+              "https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B/public",
+              "https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B/thumbnail"
+            ],
+            "uploaded": "2022-01-01T05:20:00Z"
+          }
+        }`)
+	})
+
+	uploaded, _ := time.Parse(time.RFC3339, "2022-01-01T05:20:00Z")
+	want := Image{
+		ID:       "ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B",
+		Filename: "image",
+		Metadata: map[string]interface{}{"key": "value"},
+		Variants: []string{
+			"https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B/public",
+			"https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B/thumbnail",
+		},
+		Uploaded: uploaded,
+	}
+
+	actual, err := client.UploadImage(testAccountID, strings.NewReader("fake png bytes"), map[string]string{"key": "value"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListImages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "images": [
+              {"id": "ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B", "filename": "logo.png", "variants": []}
+            ]
+          }
+        }`)
+	})
+
+	images, err := client.ListImages(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(images)) {
+			assert.Equal(t, "logo.png", images[0].Filename)
+		}
+	}
+}
+
+func TestDeleteImage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1/ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	assert.NoError(t, client.DeleteImage(testAccountID, "ZxR0pLaXRldlBtaFhhZ2EyS2R5WVNuRm1B"))
+}
+
+func TestCreateImagesVariant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1/variants", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "id": "hero",
+              "neverRequireSignedURLs": true,
+              "options": {"fit": "scale-down", "width": 1366, "height": 768, "metadata": "none"}
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "variant": {
+              "id": "hero",
+              "neverRequireSignedURLs": true,
+              "options": {"fit": "scale-down", "width": 1366, "height": 768, "metadata": "none"}
+            }
+          }
+        }`)
+	})
+
+	variant := ImagesVariant{
+		ID:                     "hero",
+		NeverRequireSignedURLs: true,
+		Options:                ImagesVariantOptions{Fit: "scale-down", Width: 1366, Height: 768, Metadata: "none"},
+	}
+	actual, err := client.CreateImagesVariant(testAccountID, variant)
+	if assert.NoError(t, err) {
+		assert.Equal(t, variant, actual)
+	}
+}
+
+func TestListImagesVariants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1/variants", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "variants": {
+              "thumbnail": {"id": "thumbnail", "options": {"fit": "cover", "width": 100, "height": 100}},
+              "hero": {"id": "hero", "options": {"fit": "scale-down", "width": 1366, "height": 768}}
+            }
+          }
+        }`)
+	})
+
+	variants, err := client.ListImagesVariants(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 2, len(variants)) {
+			assert.Equal(t, "hero", variants[0].ID)
+			assert.Equal(t, "thumbnail", variants[1].ID)
+			assert.Equal(t, "cover", variants[1].Options.Fit)
+		}
+	}
+}
+
+func TestUpdateImagesVariant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1/variants/hero", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"neverRequireSignedURLs": false, "options": {"fit": "contain", "width": 800}}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"variant": {"id": "hero", "options": {"fit": "contain", "width": 800}}}
+        }`)
+	})
+
+	actual, err := client.UpdateImagesVariant(testAccountID, ImagesVariant{
+		ID:      "hero",
+		Options: ImagesVariantOptions{Fit: "contain", Width: 800},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "contain", actual.Options.Fit)
+	}
+
+	_, err = client.UpdateImagesVariant(testAccountID, ImagesVariant{})
+	assert.Error(t, err)
+}
+
+func TestDeleteImagesVariant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/images/v1/variants/hero", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	assert.NoError(t, client.DeleteImagesVariant(testAccountID, "hero"))
+}
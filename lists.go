@@ -0,0 +1,230 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// List kinds.
+const (
+	ListKindIP       = "ip"
+	ListKindRedirect = "redirect"
+	ListKindHostname = "hostname"
+)
+
+// List describes an account level list which can be referenced by rule
+// expressions, e.g. `ip.src in $office_network`.
+type List struct {
+	ID                    string     `json:"id,omitempty"`
+	Name                  string     `json:"name"`
+	Description           string     `json:"description,omitempty"`
+	Kind                  string     `json:"kind"`
+	NumItems              int        `json:"num_items,omitempty"`
+	NumReferencingFilters int        `json:"num_referencing_filters,omitempty"`
+	CreatedOn             *time.Time `json:"created_on,omitempty"`
+	ModifiedOn            *time.Time `json:"modified_on,omitempty"`
+}
+
+// ListItem describes a single item of a list. Exactly one of IP, Redirect or
+// Hostname is set, matching the kind of the list.
+type ListItem struct {
+	ID         string            `json:"id,omitempty"`
+	IP         *string           `json:"ip,omitempty"`
+	Redirect   *ListItemRedirect `json:"redirect,omitempty"`
+	Hostname   *ListItemHostname `json:"hostname,omitempty"`
+	Comment    string            `json:"comment,omitempty"`
+	CreatedOn  *time.Time        `json:"created_on,omitempty"`
+	ModifiedOn *time.Time        `json:"modified_on,omitempty"`
+}
+
+// ListItemRedirect describes a redirect of a redirect list.
+type ListItemRedirect struct {
+	SourceURL           string `json:"source_url"`
+	TargetURL           string `json:"target_url"`
+	IncludeSubdomains   *bool  `json:"include_subdomains,omitempty"`
+	SubpathMatching     *bool  `json:"subpath_matching,omitempty"`
+	StatusCode          int    `json:"status_code,omitempty"`
+	PreserveQueryString *bool  `json:"preserve_query_string,omitempty"`
+	PreservePathSuffix  *bool  `json:"preserve_path_suffix,omitempty"`
+}
+
+// ListItemHostname describes a hostname of a hostname list.
+type ListItemHostname struct {
+	URLHostname string `json:"url_hostname"`
+}
+
+// ListBulkOperation describes the state of an asynchronous change to the
+// items of a list. Status is one of "pending", "running", "completed" or
+// "failed", in which case Error describes the failure.
+type ListBulkOperation struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	Completed *time.Time `json:"completed,omitempty"`
+}
+
+// ListResponse represents the response from the list endpoints containing a
+// single list.
+type ListResponse struct {
+	Response
+	Result List `json:"result"`
+}
+
+// ListListResponse represents the response from the endpoint listing lists.
+type ListListResponse struct {
+	Response
+	Result []List `json:"result"`
+}
+
+// ListItemsResponse represents a page of the response from the endpoint
+// listing list items. Pages are linked by cursors rather than page numbers.
+type ListItemsResponse struct {
+	Response
+	Result     []ListItem `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// ListItemsOperationResponse represents the response from the endpoints
+// changing the items of a list, which are processed asynchronously.
+type ListItemsOperationResponse struct {
+	Response
+	Result struct {
+		OperationID string `json:"operation_id"`
+	} `json:"result"`
+}
+
+// ListBulkOperationResponse represents the response from the bulk operation
+// status endpoint.
+type ListBulkOperationResponse struct {
+	Response
+	Result ListBulkOperation `json:"result"`
+}
+
+// CreateList creates a new list in the given account.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-create-list
+func (api *API) CreateList(accountID string, list List) (List, error) {
+	uri := "/accounts/" + accountID + "/rules/lists"
+	res, err := api.makeRequest("POST", uri, list)
+	if err != nil {
+		return List{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return List{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// Lists returns all lists of the given account.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-list-lists
+func (api *API) Lists(accountID string) ([]List, error) {
+	uri := "/accounts/" + accountID + "/rules/lists"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []List{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ListListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []List{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteList deletes the given list. Lists referenced by a filter can not be
+// deleted.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-delete-list
+func (api *API) DeleteList(accountID, listID string) error {
+	uri := "/accounts/" + accountID + "/rules/lists/" + listID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// CreateListItems appends items to the given list. The items are added
+// asynchronously; the returned operation ID can be passed to
+// GetListBulkOperation to track their progress.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-create-list-items
+func (api *API) CreateListItems(accountID, listID string, items []ListItem) (string, error) {
+	uri := "/accounts/" + accountID + "/rules/lists/" + listID + "/items"
+	res, err := api.makeRequest("POST", uri, items)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r ListItemsOperationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.OperationID, nil
+}
+
+// ListItems returns all items of the given list, following the result cursors
+// until the last page.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-list-list-items
+func (api *API) ListItems(accountID, listID string) ([]ListItem, error) {
+	var items []ListItem
+	uri := "/accounts/" + accountID + "/rules/lists/" + listID + "/items"
+	err := api.forEachCursorPage(uri, nil, false, func(res []byte) (ResultInfo, error) {
+		var r ListItemsResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		items = append(items, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []ListItem{}, err
+	}
+	return items, nil
+}
+
+// DeleteListItems removes the items with the given IDs from the list. Like
+// CreateListItems the removal is asynchronous and the operation ID is
+// returned.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-delete-list-items
+func (api *API) DeleteListItems(accountID, listID string, itemIDs []string) (string, error) {
+	type itemID struct {
+		ID string `json:"id"`
+	}
+	body := struct {
+		Items []itemID `json:"items"`
+	}{[]itemID{}}
+	for _, id := range itemIDs {
+		body.Items = append(body.Items, itemID{id})
+	}
+
+	uri := "/accounts/" + accountID + "/rules/lists/" + listID + "/items"
+	res, err := api.makeRequest("DELETE", uri, body)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r ListItemsOperationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.OperationID, nil
+}
+
+// GetListBulkOperation returns the current state of an asynchronous list
+// items operation.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-get-bulk-operation
+func (api *API) GetListBulkOperation(accountID, operationID string) (ListBulkOperation, error) {
+	uri := "/accounts/" + accountID + "/rules/lists/bulk_operations/" + operationID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ListBulkOperation{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ListBulkOperationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ListBulkOperation{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,136 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateList_IP(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "office_network", "description": "Office IPs", "kind": "ip"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+            "name": "office_network",
+            "description": "Office IPs",
+            "kind": "ip",
+            "num_items": 0,
+            "num_referencing_filters": 0,
+            "created_on": "2020-01-01T08:00:00Z",
+            "modified_on": "2020-01-10T14:00:00Z"
+          }
+        }`)
+	})
+
+	list, err := client.CreateList(testAccountID, List{Name: "office_network", Description: "Office IPs", Kind: ListKindIP})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2c0fc9fa937b11eaa1b71c4d701ab86e", list.ID)
+		assert.Equal(t, ListKindIP, list.Kind)
+		assert.NotNil(t, list.CreatedOn)
+	}
+}
+
+func TestCreateListItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/2c0fc9fa937b11eaa1b71c4d701ab86e/items", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[
+              {"ip": "192.0.2.1", "comment": "Office"},
+              {"ip": "198.51.100.0/24"}
+            ]`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}
+        }`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "4da8780eeb215e6cb7f48dd981c4ea02",
+            "status": "completed",
+            "completed": "2020-01-01T08:00:00Z"
+          }
+        }`)
+	})
+
+	ip1, ip2 := "192.0.2.1", "198.51.100.0/24"
+	opID, err := client.CreateListItems(testAccountID, "2c0fc9fa937b11eaa1b71c4d701ab86e", []ListItem{
+		{IP: &ip1, Comment: "Office"},
+		{IP: &ip2},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "4da8780eeb215e6cb7f48dd981c4ea02", opID)
+	}
+
+	op, err := client.GetListBulkOperation(testAccountID, opID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "completed", op.Status)
+		assert.NotNil(t, op.Completed)
+	}
+}
+
+func TestListItems_Cursor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/2c0fc9fa937b11eaa1b71c4d701ab86e/items", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "7c5dae5552338874e5053f2534d2767a", "ip": "192.0.2.1"}],
+              "result_info": {"cursors": {"after": "yyy"}}
+            }`)
+		case "yyy":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": [{"id": "8d6ebf6663449985f6164g3645e3878b", "hostname": {"url_hostname": "example.com"}}],
+              "result_info": {"cursors": {"before": "yyy"}}
+            }`)
+		}
+	})
+
+	items, err := client.ListItems(testAccountID, "2c0fc9fa937b11eaa1b71c4d701ab86e")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(items))
+		assert.Equal(t, "192.0.2.1", *items[0].IP)
+		assert.Equal(t, &ListItemHostname{URLHostname: "example.com"}, items[1].Hostname)
+	}
+}
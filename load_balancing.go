@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
@@ -71,6 +70,33 @@ type loadBalancerPoolResponse struct {
 	Result LoadBalancerPool `json:"result"`
 }
 
+// LoadBalancerPoolHealth represents the healthcheck status of a load balancer
+// pool as seen from each Cloudflare PoP, keyed by region.
+type LoadBalancerPoolHealth struct {
+	ID        string                               `json:"pool_id,omitempty"`
+	PopHealth map[string]LoadBalancerPoolPopHealth `json:"pop_health,omitempty"`
+}
+
+// LoadBalancerPoolPopHealth represents the health of a pool from a single region.
+type LoadBalancerPoolPopHealth struct {
+	Healthy bool                                  `json:"healthy,omitempty"`
+	Origins []map[string]LoadBalancerOriginHealth `json:"origins,omitempty"`
+}
+
+// LoadBalancerOriginHealth represents the health of a single origin within a pool.
+type LoadBalancerOriginHealth struct {
+	Healthy       bool   `json:"healthy,omitempty"`
+	RTT           string `json:"rtt,omitempty"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	ResponseCode  int    `json:"response_code,omitempty"`
+}
+
+// loadBalancerPoolHealthResponse represents the response from the Pool Health Details endpoint.
+type loadBalancerPoolHealthResponse struct {
+	Response
+	Result LoadBalancerPoolHealth `json:"result"`
+}
+
 // loadBalancerPoolListResponse represents the response from the List Pools endpoint.
 type loadBalancerPoolListResponse struct {
 	Response
@@ -114,7 +140,7 @@ func (api *API) CreateLoadBalancerPool(pool LoadBalancerPool) (LoadBalancerPool,
 		return LoadBalancerPool{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerPoolResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancerPool{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -130,7 +156,7 @@ func (api *API) ListLoadBalancerPools() ([]LoadBalancerPool, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerPoolListResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -146,12 +172,30 @@ func (api *API) LoadBalancerPoolDetails(poolID string) (LoadBalancerPool, error)
 		return LoadBalancerPool{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerPoolResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancerPool{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
 }
 
+// LoadBalancerPoolHealthDetails returns the health of each origin in a load
+// balancer pool of the given account, as observed from every region running
+// health checks.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-pool-health-details
+func (api *API) LoadBalancerPoolHealthDetails(accountID, poolID string) (LoadBalancerPoolHealth, error) {
+	uri := "/accounts/" + accountID + "/load_balancers/pools/" + poolID + "/health"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return LoadBalancerPoolHealth{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r loadBalancerPoolHealthResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return LoadBalancerPoolHealth{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
 // DeleteLoadBalancerPool disables and deletes a load balancer pool.
 //
 // API reference: https://api.cloudflare.com/#load-balancer-pools-delete-a-pool
@@ -173,7 +217,7 @@ func (api *API) ModifyLoadBalancerPool(pool LoadBalancerPool) (LoadBalancerPool,
 		return LoadBalancerPool{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerPoolResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancerPool{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -189,7 +233,7 @@ func (api *API) CreateLoadBalancerMonitor(monitor LoadBalancerMonitor) (LoadBala
 		return LoadBalancerMonitor{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerMonitorResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancerMonitor{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -205,7 +249,7 @@ func (api *API) ListLoadBalancerMonitors() ([]LoadBalancerMonitor, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerMonitorListResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -221,7 +265,7 @@ func (api *API) LoadBalancerMonitorDetails(monitorID string) (LoadBalancerMonito
 		return LoadBalancerMonitor{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerMonitorResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancerMonitor{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -248,7 +292,7 @@ func (api *API) ModifyLoadBalancerMonitor(monitor LoadBalancerMonitor) (LoadBala
 		return LoadBalancerMonitor{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerMonitorResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancerMonitor{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -264,7 +308,7 @@ func (api *API) CreateLoadBalancer(zoneID string, lb LoadBalancer) (LoadBalancer
 		return LoadBalancer{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancer{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -280,7 +324,7 @@ func (api *API) ListLoadBalancers(zoneID string) ([]LoadBalancer, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerListResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -296,7 +340,7 @@ func (api *API) LoadBalancerDetails(zoneID, lbID string) (LoadBalancer, error) {
 		return LoadBalancer{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancer{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -323,8 +367,53 @@ func (api *API) ModifyLoadBalancer(zoneID string, lb LoadBalancer) (LoadBalancer
 		return LoadBalancer{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r loadBalancerResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return LoadBalancer{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
 }
+
+// ErrLoadBalancerNotFound is returned by LoadBalancerIDByName when no load
+// balancer has the given name.
+var ErrLoadBalancerNotFound = errors.New("load balancer could not be found")
+
+// ErrLoadBalancerPoolNotFound is returned by LoadBalancerPoolIDByName when no
+// pool has the given name.
+var ErrLoadBalancerPoolNotFound = errors.New("load balancer pool could not be found")
+
+// LoadBalancerIDByName returns the ID of the load balancer of the given zone
+// whose name, its DNS hostname, is exactly name.
+func (api *API) LoadBalancerIDByName(zoneID, name string) (string, error) {
+	lbs, err := api.ListLoadBalancers(zoneID)
+	if err != nil {
+		return "", err
+	}
+	for _, lb := range lbs {
+		if lb.Name == name {
+			return lb.ID, nil
+		}
+	}
+	return "", ErrLoadBalancerNotFound
+}
+
+// LoadBalancerPoolIDByName returns the ID of the pool of the given account
+// whose name is exactly name.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-list-pools
+func (api *API) LoadBalancerPoolIDByName(accountID, name string) (string, error) {
+	uri := "/accounts/" + accountID + "/load_balancers/pools"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r loadBalancerPoolListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	for _, pool := range r.Result {
+		if pool.Name == name {
+			return pool.ID, nil
+		}
+	}
+	return "", ErrLoadBalancerPoolNotFound
+}
@@ -251,6 +251,82 @@ func TestLoadBalancerPoolDetails(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestLoadBalancerPoolHealthDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET", "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+            "success": true,
+            "errors": [],
+            "messages": [],
+            "result": {
+              "pool_id": "17b5962d775c646f3f9725cbc7a53df4",
+              "pop_health": {
+                "Amsterdam, NL": {
+                  "healthy": false,
+                  "origins": [
+                    {
+                      "192.0.2.1": {
+                        "healthy": true,
+                        "rtt": "12.1ms",
+                        "failure_reason": "No failures",
+                        "response_code": 200
+                      }
+                    },
+                    {
+                      "192.0.2.2": {
+                        "healthy": false,
+                        "rtt": "0s",
+                        "failure_reason": "HTTP timeout occurred",
+                        "response_code": 0
+                      }
+                    }
+                  ]
+                }
+              }
+            }
+        }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4/health", handler)
+	want := LoadBalancerPoolHealth{
+		ID: "17b5962d775c646f3f9725cbc7a53df4",
+		PopHealth: map[string]LoadBalancerPoolPopHealth{
+			"Amsterdam, NL": {
+				Healthy: false,
+				Origins: []map[string]LoadBalancerOriginHealth{
+					{
+						"192.0.2.1": {
+							Healthy:       true,
+							RTT:           "12.1ms",
+							FailureReason: "No failures",
+							ResponseCode:  200,
+						},
+					},
+					{
+						"192.0.2.2": {
+							Healthy:       false,
+							RTT:           "0s",
+							FailureReason: "HTTP timeout occurred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := client.LoadBalancerPoolHealthDetails(testAccountID, "17b5962d775c646f3f9725cbc7a53df4")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.LoadBalancerPoolHealthDetails(testAccountID, "bar")
+	assert.Error(t, err)
+}
+
 func TestDeleteLoadBalancerPool(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1306,3 +1382,57 @@ func TestModifyLoadBalancer(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestLoadBalancerIDByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/199d98642c564d2e855e9661899b7252/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+            "success": true,
+            "errors": [],
+            "messages": [],
+            "result": [
+                {"id": "699d98642c564d2e855e9661899b7252", "name": "www.example.com.au"},
+                {"id": "8bd2d1a5e5e24ebc9ccd1f0d35d0a1cc", "name": "www.example.com"}
+            ]
+        }`)
+	})
+
+	id, err := client.LoadBalancerIDByName("199d98642c564d2e855e9661899b7252", "www.example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "8bd2d1a5e5e24ebc9ccd1f0d35d0a1cc", id)
+	}
+
+	_, err = client.LoadBalancerIDByName("199d98642c564d2e855e9661899b7252", "example.com")
+	assert.Equal(t, ErrLoadBalancerNotFound, err)
+}
+
+func TestLoadBalancerPoolIDByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/load_balancers/pools", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+            "success": true,
+            "errors": [],
+            "messages": [],
+            "result": [
+                {"id": "17b5962d775c646f3f9725cbc7a53df4", "name": "primary-dc-1"},
+                {"id": "9290f38c5d07c2e2f4df57b1f61d4196", "name": "primary-dc"}
+            ]
+        }`)
+	})
+
+	id, err := client.LoadBalancerPoolIDByName(testAccountID, "primary-dc")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "9290f38c5d07c2e2f4df57b1f61d4196", id)
+	}
+
+	_, err = client.LoadBalancerPoolIDByName(testAccountID, "secondary-dc")
+	assert.Equal(t, ErrLoadBalancerPoolNotFound, err)
+}
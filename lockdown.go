@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"net/url"
 	"strconv"
 
@@ -52,7 +51,7 @@ func (api *API) CreateZoneLockdown(zoneID string, ld ZoneLockdown) (*ZoneLockdow
 	}
 
 	response := &ZoneLockdownResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -72,7 +71,7 @@ func (api *API) UpdateZoneLockdown(zoneID string, id string, ld ZoneLockdown) (*
 	}
 
 	response := &ZoneLockdownResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -92,7 +91,7 @@ func (api *API) DeleteZoneLockdown(zoneID string, id string) (*ZoneLockdownRespo
 	}
 
 	response := &ZoneLockdownResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -112,7 +111,7 @@ func (api *API) ZoneLockdown(zoneID string, id string) (*ZoneLockdownResponse, e
 	}
 
 	response := &ZoneLockdownResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -141,7 +140,7 @@ func (api *API) ListZoneLockdowns(zoneID string, page int) (*ZoneLockdownListRes
 	}
 
 	response := &ZoneLockdownListResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
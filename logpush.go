@@ -0,0 +1,69 @@
+package cloudflare
+
+import (
+	"github.com/pkg/errors"
+)
+
+// LogpushFieldsResponse represents the response from the Logpush dataset
+// fields endpoint, mapping each field name to its description.
+type LogpushFieldsResponse struct {
+	Response
+	Result map[string]string `json:"result"`
+}
+
+// LogpushFields returns the fields available in the given Logpush dataset,
+// e.g. "http_requests", mapped to their descriptions. They are the fields
+// which can be listed in the logpull_options of a job.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-list-all-fields
+func (api *API) LogpushFields(zoneID, dataset string) (map[string]string, error) {
+	uri := "/zones/" + zoneID + "/logpush/datasets/" + dataset + "/fields"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	var r LogpushFieldsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// LogpushDestinationValidationResponse represents the response from the
+// Logpush destination validation endpoint.
+type LogpushDestinationValidationResponse struct {
+	Response
+	Result struct {
+		Valid   bool   `json:"valid"`
+		Message string `json:"message"`
+	} `json:"result"`
+}
+
+// ValidateLogpushDestination checks that Cloudflare can write to the given
+// destination, e.g. "s3://bucket/logs?region=us-west-2", before a job using
+// it is created. An invalid destination is reported as false along with an
+// error holding the reason given by the API.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-validate-destination
+func (api *API) ValidateLogpushDestination(zoneID, destinationConf string) (bool, error) {
+	uri := "/zones/" + zoneID + "/logpush/validate/destination"
+	res, err := api.makeRequest("POST", uri, struct {
+		DestinationConf string `json:"destination_conf"`
+	}{destinationConf})
+	if err != nil {
+		return false, errors.Wrap(err, errMakeRequestError)
+	}
+	var r LogpushDestinationValidationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return false, errors.Wrap(err, errUnmarshalError)
+	}
+	if !r.Result.Valid {
+		// destinationConf is left out as it may hold credentials, e.g. a
+		// SAS token for Azure
+		if r.Result.Message == "" {
+			return false, errors.New("invalid Logpush destination")
+		}
+		return false, errors.Errorf("invalid Logpush destination: %s", r.Result.Message)
+	}
+	return true, nil
+}
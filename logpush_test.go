@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogpushFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/logpush/datasets/http_requests/fields", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "ClientIP": "IP address of the client",
+            "ClientRequestHost": "Host requested by the client",
+            "EdgeResponseStatus": "HTTP status code returned by Cloudflare to the client",
+            "RayID": "ID of the request"
+          }
+        }`)
+	})
+
+	want := map[string]string{
+		"ClientIP":           "IP address of the client",
+		"ClientRequestHost":  "Host requested by the client",
+		"EdgeResponseStatus": "HTTP status code returned by Cloudflare to the client",
+		"RayID":              "ID of the request",
+	}
+
+	fields, err := client.LogpushFields("023e105f4ecef8ad9ca31a8372d0c353", "http_requests")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, fields)
+	}
+}
+
+func TestValidateLogpushDestination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/logpush/validate/destination", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"destination_conf": "s3://logs-bucket/http?region=us-west-2"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"valid": true, "message": ""}}`)
+	})
+
+	valid, err := client.ValidateLogpushDestination("023e105f4ecef8ad9ca31a8372d0c353", "s3://logs-bucket/http?region=us-west-2")
+	if assert.NoError(t, err) {
+		assert.True(t, valid)
+	}
+}
+
+func TestValidateLogpushDestination_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/logpush/validate/destination", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"valid": false, "message": "error validating destination: access denied"}
+        }`)
+	})
+
+	valid, err := client.ValidateLogpushDestination("023e105f4ecef8ad9ca31a8372d0c353", "s3://other-bucket/http?region=us-west-2")
+	assert.False(t, valid)
+	if assert.Error(t, err) {
+		assert.Equal(t, "invalid Logpush destination: error validating destination: access denied", err.Error())
+	}
+}
@@ -0,0 +1,195 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MagicTransitStaticRoute describes a static route used by Magic Transit to
+// steer traffic towards a GRE or IPsec tunnel.
+type MagicTransitStaticRoute struct {
+	ID          string                        `json:"id,omitempty"`
+	Prefix      string                        `json:"prefix"`
+	Nexthop     string                        `json:"nexthop"`
+	Priority    int                           `json:"priority"`
+	Weight      int                           `json:"weight,omitempty"`
+	Description string                        `json:"description,omitempty"`
+	Scope       *MagicTransitStaticRouteScope `json:"scope,omitempty"`
+	CreatedOn   *time.Time                    `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time                    `json:"modified_on,omitempty"`
+}
+
+// MagicTransitStaticRouteScope limits a static route to the given colo
+// regions or colo names. An empty scope applies the route everywhere.
+type MagicTransitStaticRouteScope struct {
+	ColoRegions []string `json:"colo_regions,omitempty"`
+	ColoNames   []string `json:"colo_names,omitempty"`
+}
+
+// MagicTransitStaticRoutesResponse represents the response from the create
+// and list static routes endpoints.
+type MagicTransitStaticRoutesResponse struct {
+	Response
+	Result struct {
+		Routes []MagicTransitStaticRoute `json:"routes"`
+	} `json:"result"`
+}
+
+// MagicTransitStaticRouteResponse represents the response from the static
+// route details endpoint.
+type MagicTransitStaticRouteResponse struct {
+	Response
+	Result struct {
+		Route MagicTransitStaticRoute `json:"route"`
+	} `json:"result"`
+}
+
+// UpdateMagicTransitStaticRouteResponse represents the response from the
+// update static route endpoint.
+type UpdateMagicTransitStaticRouteResponse struct {
+	Response
+	Result struct {
+		Modified      bool                    `json:"modified"`
+		ModifiedRoute MagicTransitStaticRoute `json:"modified_route"`
+	} `json:"result"`
+}
+
+// DeleteMagicTransitStaticRouteResponse represents the response from the
+// delete static route endpoint.
+type DeleteMagicTransitStaticRouteResponse struct {
+	Response
+	Result struct {
+		Deleted      bool                    `json:"deleted"`
+		DeletedRoute MagicTransitStaticRoute `json:"deleted_route"`
+	} `json:"result"`
+}
+
+// CreateMagicTransitStaticRoute creates a new static route for the given
+// account and returns the account's routes.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-create-routes
+func (api *API) CreateMagicTransitStaticRoute(accountID string, route MagicTransitStaticRoute) ([]MagicTransitStaticRoute, error) {
+	uri := "/accounts/" + accountID + "/magic/routes"
+	res, err := api.makeRequest("POST", uri, struct {
+		Routes []MagicTransitStaticRoute `json:"routes"`
+	}{[]MagicTransitStaticRoute{route}})
+	if err != nil {
+		return []MagicTransitStaticRoute{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r MagicTransitStaticRoutesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []MagicTransitStaticRoute{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Routes, nil
+}
+
+// ListMagicTransitStaticRoutes lists the static routes of the given account.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-list-routes
+func (api *API) ListMagicTransitStaticRoutes(accountID string) ([]MagicTransitStaticRoute, error) {
+	uri := "/accounts/" + accountID + "/magic/routes"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r MagicTransitStaticRoutesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []MagicTransitStaticRoute{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Routes, nil
+}
+
+// MagicTransitStaticRouteFilter selects static routes by their prefix,
+// nexthop or colo region. Empty fields match every route.
+type MagicTransitStaticRouteFilter struct {
+	Prefix     string
+	Nexthop    string
+	ColoRegion string
+}
+
+// matches reports whether route satisfies every set field of f.
+func (f MagicTransitStaticRouteFilter) matches(route MagicTransitStaticRoute) bool {
+	if f.Prefix != "" && route.Prefix != f.Prefix {
+		return false
+	}
+	if f.Nexthop != "" && route.Nexthop != f.Nexthop {
+		return false
+	}
+	if f.ColoRegion != "" && (route.Scope == nil || !containsString(route.Scope.ColoRegions, f.ColoRegion)) {
+		return false
+	}
+	return true
+}
+
+// FilterMagicTransitStaticRoutes lists the static routes of the given account
+// which match filter. The API has no filter parameters, so all routes are
+// fetched and filtered by the client.
+func (api *API) FilterMagicTransitStaticRoutes(accountID string, filter MagicTransitStaticRouteFilter) ([]MagicTransitStaticRoute, error) {
+	routes, err := api.ListMagicTransitStaticRoutes(accountID)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+	matched := []MagicTransitStaticRoute{}
+	for _, route := range routes {
+		if filter.matches(route) {
+			matched = append(matched, route)
+		}
+	}
+	return matched, nil
+}
+
+// MagicTransitStaticRoute returns the details of a single static route.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-route-details
+func (api *API) MagicTransitStaticRoute(accountID, routeID string) (MagicTransitStaticRoute, error) {
+	uri := "/accounts/" + accountID + "/magic/routes/" + routeID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return MagicTransitStaticRoute{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r MagicTransitStaticRouteResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return MagicTransitStaticRoute{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Route, nil
+}
+
+// UpdateMagicTransitStaticRoute updates a single static route.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-update-route
+func (api *API) UpdateMagicTransitStaticRoute(accountID, routeID string, route MagicTransitStaticRoute) (MagicTransitStaticRoute, error) {
+	uri := "/accounts/" + accountID + "/magic/routes/" + routeID
+	res, err := api.makeRequest("PUT", uri, route)
+	if err != nil {
+		return MagicTransitStaticRoute{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r UpdateMagicTransitStaticRouteResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return MagicTransitStaticRoute{}, errors.Wrap(err, errUnmarshalError)
+	}
+	if !r.Result.Modified {
+		return MagicTransitStaticRoute{}, errors.New("static route was not modified")
+	}
+	return r.Result.ModifiedRoute, nil
+}
+
+// DeleteMagicTransitStaticRoute deletes a single static route and returns the
+// deleted route.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-delete-route
+func (api *API) DeleteMagicTransitStaticRoute(accountID, routeID string) (MagicTransitStaticRoute, error) {
+	uri := "/accounts/" + accountID + "/magic/routes/" + routeID
+	res, err := api.makeRequest("DELETE", uri, nil)
+	if err != nil {
+		return MagicTransitStaticRoute{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r DeleteMagicTransitStaticRouteResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return MagicTransitStaticRoute{}, errors.Wrap(err, errUnmarshalError)
+	}
+	if !r.Result.Deleted {
+		return MagicTransitStaticRoute{}, errors.New("static route was not deleted")
+	}
+	return r.Result.DeletedRoute, nil
+}
@@ -0,0 +1,194 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountID = "01a7362d577a6c3019a474fd6f485823"
+
+func TestCreateMagicTransitStaticRoute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "routes": [
+                {
+                  "prefix": "192.0.2.0/24",
+                  "nexthop": "10.0.0.0",
+                  "priority": 200,
+                  "weight": 10,
+                  "description": "New route for new prefix 192.0.2.0/24"
+                }
+              ]
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "routes": [
+              {
+                "id": "c4a7362d577a6c3019a474fd6f485821",
+                "prefix": "192.0.2.0/24",
+                "nexthop": "10.0.0.0",
+                "priority": 200,
+                "weight": 10,
+                "description": "New route for new prefix 192.0.2.0/24",
+                "created_on": "2017-06-14T00:00:00Z",
+                "modified_on": "2017-06-14T05:20:00Z"
+              }
+            ]
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/routes", handler)
+
+	createdOn, _ := time.Parse(time.RFC3339, "2017-06-14T00:00:00Z")
+	modifiedOn, _ := time.Parse(time.RFC3339, "2017-06-14T05:20:00Z")
+	want := []MagicTransitStaticRoute{{
+		ID:          "c4a7362d577a6c3019a474fd6f485821",
+		Prefix:      "192.0.2.0/24",
+		Nexthop:     "10.0.0.0",
+		Priority:    200,
+		Weight:      10,
+		Description: "New route for new prefix 192.0.2.0/24",
+		CreatedOn:   &createdOn,
+		ModifiedOn:  &modifiedOn,
+	}}
+
+	actual, err := client.CreateMagicTransitStaticRoute(testAccountID, MagicTransitStaticRoute{
+		Prefix:      "192.0.2.0/24",
+		Nexthop:     "10.0.0.0",
+		Priority:    200,
+		Weight:      10,
+		Description: "New route for new prefix 192.0.2.0/24",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListMagicTransitStaticRoutes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/routes", handleMagicTransitStaticRoutes(t))
+
+	actual, err := client.ListMagicTransitStaticRoutes(testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(actual))
+		assert.Equal(t, 100, actual[0].Priority)
+		assert.Nil(t, actual[0].Scope)
+		assert.Equal(t, &MagicTransitStaticRouteScope{
+			ColoRegions: []string{"APAC"},
+			ColoNames:   []string{"den01"},
+		}, actual[1].Scope)
+	}
+}
+
+func TestFilterMagicTransitStaticRoutes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/routes", handleMagicTransitStaticRoutes(t))
+
+	actual, err := client.FilterMagicTransitStaticRoutes(testAccountID, MagicTransitStaticRouteFilter{Nexthop: "10.0.0.1"})
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(actual)) {
+		assert.Equal(t, "198.51.100.0/24", actual[0].Prefix)
+	}
+
+	actual, err = client.FilterMagicTransitStaticRoutes(testAccountID, MagicTransitStaticRouteFilter{ColoRegion: "APAC"})
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(actual)) {
+		assert.Equal(t, "d5b8473e688b7d4120b585ge7g596932", actual[0].ID)
+	}
+
+	actual, err = client.FilterMagicTransitStaticRoutes(testAccountID, MagicTransitStaticRouteFilter{Prefix: "192.0.2.0/24", Nexthop: "10.0.0.1"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, actual)
+	}
+
+	actual, err = client.FilterMagicTransitStaticRoutes(testAccountID, MagicTransitStaticRouteFilter{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(actual))
+	}
+}
+
+// handleMagicTransitStaticRoutes serves a list of two static routes, the
+// second one scoped to a colo region.
+func handleMagicTransitStaticRoutes(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "routes": [
+              {
+                "id": "c4a7362d577a6c3019a474fd6f485821",
+                "prefix": "192.0.2.0/24",
+                "nexthop": "10.0.0.0",
+                "priority": 100
+              },
+              {
+                "id": "d5b8473e688b7d4120b585ge7g596932",
+                "prefix": "198.51.100.0/24",
+                "nexthop": "10.0.0.1",
+                "priority": 200,
+                "scope": {
+                  "colo_regions": ["APAC"],
+                  "colo_names": ["den01"]
+                }
+              }
+            ]
+          }
+        }`)
+	}
+}
+
+func TestDeleteMagicTransitStaticRoute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "deleted": true,
+            "deleted_route": {
+              "id": "c4a7362d577a6c3019a474fd6f485821",
+              "prefix": "192.0.2.0/24",
+              "nexthop": "10.0.0.0",
+              "priority": 100
+            }
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/routes/c4a7362d577a6c3019a474fd6f485821", handler)
+
+	actual, err := client.DeleteMagicTransitStaticRoute(testAccountID, "c4a7362d577a6c3019a474fd6f485821")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "192.0.2.0/24", actual.Prefix)
+	}
+}
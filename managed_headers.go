@@ -0,0 +1,84 @@
+package cloudflare
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ManagedHeader describes a single managed transform, which adds or removes
+// a common request or response header without writing a rule.
+type ManagedHeader struct {
+	ID            string   `json:"id"`
+	Enabled       bool     `json:"enabled"`
+	HasConflict   bool     `json:"has_conflict,omitempty"`
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+}
+
+// ManagedHeaders contains the managed request and response header transforms
+// available to a zone.
+type ManagedHeaders struct {
+	ManagedRequestHeaders  []ManagedHeader `json:"managed_request_headers"`
+	ManagedResponseHeaders []ManagedHeader `json:"managed_response_headers"`
+}
+
+// ManagedHeaderToggle enables or disables the managed transform with the
+// given ID.
+type ManagedHeaderToggle struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+// ManagedHeadersUpdate lists the managed transforms to change. Transforms
+// that are not listed are left untouched.
+type ManagedHeadersUpdate struct {
+	ManagedRequestHeaders  []ManagedHeaderToggle `json:"managed_request_headers"`
+	ManagedResponseHeaders []ManagedHeaderToggle `json:"managed_response_headers"`
+}
+
+// ManagedHeadersResponse represents the response from the managed headers
+// endpoint.
+type ManagedHeadersResponse struct {
+	Response
+	Result ManagedHeaders `json:"result"`
+}
+
+// ManagedHeaders returns the managed transforms of the given zone along with
+// their state.
+//
+// API reference: https://api.cloudflare.com/#managed-transforms-list-managed-transforms
+func (api *API) ManagedHeaders(zoneID string) (ManagedHeaders, error) {
+	uri := "/zones/" + zoneID + "/managed_headers"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ManagedHeaders{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ManagedHeadersResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ManagedHeaders{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateManagedHeaders enables or disables the managed transforms listed in
+// update and returns the resulting state of all managed transforms.
+//
+// API reference: https://api.cloudflare.com/#managed-transforms-update-status-of-managed-transforms
+func (api *API) UpdateManagedHeaders(zoneID string, update ManagedHeadersUpdate) (ManagedHeaders, error) {
+	// The API expects both lists to be present, even if empty.
+	if update.ManagedRequestHeaders == nil {
+		update.ManagedRequestHeaders = []ManagedHeaderToggle{}
+	}
+	if update.ManagedResponseHeaders == nil {
+		update.ManagedResponseHeaders = []ManagedHeaderToggle{}
+	}
+
+	uri := "/zones/" + zoneID + "/managed_headers"
+	res, err := api.makeRequest("PATCH", uri, update)
+	if err != nil {
+		return ManagedHeaders{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ManagedHeadersResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ManagedHeaders{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
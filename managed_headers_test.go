@@ -0,0 +1,95 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/managed_headers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "managed_request_headers": [
+              {"id": "add_true_client_ip_headers", "enabled": false, "has_conflict": false},
+              {"id": "remove_visitor_ip_headers", "enabled": true, "has_conflict": true, "conflicts_with": ["add_true_client_ip_headers"]}
+            ],
+            "managed_response_headers": [
+              {"id": "add_security_headers", "enabled": false}
+            ]
+          }
+        }`)
+	})
+
+	want := ManagedHeaders{
+		ManagedRequestHeaders: []ManagedHeader{
+			{ID: "add_true_client_ip_headers"},
+			{ID: "remove_visitor_ip_headers", Enabled: true, HasConflict: true, ConflictsWith: []string{"add_true_client_ip_headers"}},
+		},
+		ManagedResponseHeaders: []ManagedHeader{
+			{ID: "add_security_headers"},
+		},
+	}
+
+	actual, err := client.ManagedHeaders("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateManagedHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/managed_headers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			// only the toggled transform is sent so the rest stay untouched
+			assert.JSONEq(t, `{
+              "managed_request_headers": [
+                {"id": "add_true_client_ip_headers", "enabled": true}
+              ],
+              "managed_response_headers": []
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "managed_request_headers": [
+              {"id": "add_true_client_ip_headers", "enabled": true},
+              {"id": "add_visitor_location_headers", "enabled": false}
+            ],
+            "managed_response_headers": [
+              {"id": "add_security_headers", "enabled": true}
+            ]
+          }
+        }`)
+	})
+
+	actual, err := client.UpdateManagedHeaders("023e105f4ecef8ad9ca31a8372d0c353", ManagedHeadersUpdate{
+		ManagedRequestHeaders: []ManagedHeaderToggle{{ID: "add_true_client_ip_headers", Enabled: true}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ManagedHeader{
+			{ID: "add_true_client_ip_headers", Enabled: true},
+			{ID: "add_visitor_location_headers"},
+		}, actual.ManagedRequestHeaders)
+		assert.Equal(t, []ManagedHeader{{ID: "add_security_headers", Enabled: true}}, actual.ManagedResponseHeaders)
+	}
+}
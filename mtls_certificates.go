@@ -0,0 +1,119 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MTLSCertificateParams holds a certificate uploaded to an account for use
+// with mutual TLS. Set CA to upload a certificate authority that client
+// certificates are verified against; PrivateKey is then not required.
+type MTLSCertificateParams struct {
+	Name         string `json:"name,omitempty"`
+	Certificates string `json:"certificates"`
+	PrivateKey   string `json:"private_key,omitempty"`
+	CA           bool   `json:"ca"`
+}
+
+// String implements fmt.Stringer, redacting the private key so the params
+// can be logged safely.
+func (p MTLSCertificateParams) String() string {
+	return fmt.Sprintf("{Name:%s Certificates:%s PrivateKey:[REDACTED] CA:%t}", p.Name, p.Certificates, p.CA)
+}
+
+// MTLSCertificate represents a certificate uploaded to an account for use
+// with mutual TLS. The private key is never returned.
+type MTLSCertificate struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Issuer       string    `json:"issuer"`
+	Signature    string    `json:"signature"`
+	SerialNumber string    `json:"serial_number"`
+	Certificates string    `json:"certificates"`
+	CA           bool      `json:"ca"`
+	UploadedOn   time.Time `json:"uploaded_on"`
+	ExpiresOn    time.Time `json:"expires_on"`
+}
+
+// mTLSCertificateResponse represents the response from the mTLS certificate
+// upload endpoint.
+type mTLSCertificateResponse struct {
+	Response
+	Result MTLSCertificate `json:"result"`
+}
+
+// hostnameAssociationsResponse represents the response from the hostname
+// associations endpoint.
+type hostnameAssociationsResponse struct {
+	Response
+	Result struct {
+		Hostnames []string `json:"hostnames"`
+	} `json:"result"`
+}
+
+// UploadmTLSCertificate uploads a certificate, typically a client CA, to the
+// given account for use with mutual TLS.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-upload-mtls-certificate
+func (api *API) UploadmTLSCertificate(accountID string, params MTLSCertificateParams) (MTLSCertificate, error) {
+	uri := "/accounts/" + accountID + "/mtls_certificates"
+	res, err := api.makeRequest("POST", uri, params)
+	if err != nil {
+		return MTLSCertificate{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r mTLSCertificateResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return MTLSCertificate{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateHostnameAssociation binds the given mTLS certificate to hostnames of
+// the zone, such as custom hostnames, so that clients connecting to them must
+// present a certificate issued by it. The associations replace any existing
+// ones for the certificate; the associated hostnames are returned.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-replace-hostname-associations
+func (api *API) CreateHostnameAssociation(zoneID, mtlsCertificateID string, hostnames []string) ([]string, error) {
+	if mtlsCertificateID == "" {
+		return nil, errors.New("mTLS certificate ID cannot be empty")
+	}
+	uri := "/zones/" + zoneID + "/certificate_authorities/hostname_associations"
+	res, err := api.makeRequest("PUT", uri, struct {
+		Hostnames         []string `json:"hostnames"`
+		MTLSCertificateID string   `json:"mtls_certificate_id"`
+	}{hostnames, mtlsCertificateID})
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	var r hostnameAssociationsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Hostnames, nil
+}
+
+// ListHostnameAssociations returns the hostnames of the zone associated with
+// the given mTLS certificate.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-list-hostname-associations
+func (api *API) ListHostnameAssociations(zoneID, mtlsCertificateID string) ([]string, error) {
+	if mtlsCertificateID == "" {
+		return nil, errors.New("mTLS certificate ID cannot be empty")
+	}
+	v := url.Values{}
+	v.Set("mtls_certificate_id", mtlsCertificateID)
+	uri := "/zones/" + zoneID + "/certificate_authorities/hostname_associations?" + v.Encode()
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	var r hostnameAssociationsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Hostnames, nil
+}
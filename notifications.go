@@ -0,0 +1,141 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NotificationPolicy describes an alert of the given AlertType, e.g.
+// "universal_ssl_event_type", sent through Mechanisms. Filters narrows the
+// events alerted on, e.g. to given zones.
+type NotificationPolicy struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     bool                   `json:"enabled"`
+	AlertType   string                 `json:"alert_type"`
+	Mechanisms  NotificationMechanisms `json:"mechanisms"`
+	Filters     map[string][]string    `json:"filters,omitempty"`
+	Conditions  map[string]interface{} `json:"conditions,omitempty"`
+	Created     *time.Time             `json:"created,omitempty"`
+	Modified    *time.Time             `json:"modified,omitempty"`
+}
+
+// NotificationMechanisms lists the destinations alerts are delivered to.
+// Email destinations are identified by address, the others by the ID of the
+// destination.
+type NotificationMechanisms struct {
+	Email     []NotificationMechanism `json:"email,omitempty"`
+	Webhooks  []NotificationMechanism `json:"webhooks,omitempty"`
+	PagerDuty []NotificationMechanism `json:"pagerduty,omitempty"`
+}
+
+// NotificationMechanism identifies a single alert destination.
+type NotificationMechanism struct {
+	ID string `json:"id"`
+}
+
+// NotificationWebhook describes a webhook alert destination. Secret is only
+// sent when creating the webhook and is never returned.
+type NotificationWebhook struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name"`
+	URL         string     `json:"url"`
+	Secret      string     `json:"secret,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastFailure *time.Time `json:"last_failure,omitempty"`
+}
+
+// NotificationResourceIDResponse represents the response from the
+// notification endpoints which only return the ID of the created resource.
+type NotificationResourceIDResponse struct {
+	Response
+	Result struct {
+		ID string `json:"id"`
+	} `json:"result"`
+}
+
+// NotificationPoliciesResponse represents the response from the list
+// notification policies endpoint.
+type NotificationPoliciesResponse struct {
+	Response
+	Result []NotificationPolicy `json:"result"`
+}
+
+// NotificationWebhooksResponse represents the response from the list
+// notification webhooks endpoint.
+type NotificationWebhooksResponse struct {
+	Response
+	Result []NotificationWebhook `json:"result"`
+}
+
+// CreateNotificationPolicy creates a notification policy in the given account
+// and returns its ID.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-create-notification-policy
+func (api *API) CreateNotificationPolicy(accountID string, policy NotificationPolicy) (string, error) {
+	uri := "/accounts/" + accountID + "/alerting/v3/policies"
+	res, err := api.makeRequest("POST", uri, policy)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r NotificationResourceIDResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.ID, nil
+}
+
+// NotificationPolicies returns the notification policies of the given
+// account.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-list-notification-policies
+func (api *API) NotificationPolicies(accountID string) ([]NotificationPolicy, error) {
+	uri := "/accounts/" + accountID + "/alerting/v3/policies"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []NotificationPolicy{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r NotificationPoliciesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []NotificationPolicy{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateNotificationWebhook creates a webhook destination in the given
+// account and returns its ID. Cloudflare sends a test message to the URL
+// before accepting it.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-create-webhook
+func (api *API) CreateNotificationWebhook(accountID string, webhook NotificationWebhook) (string, error) {
+	uri := "/accounts/" + accountID + "/alerting/v3/destinations/webhooks"
+	res, err := api.makeRequest("POST", uri, webhook)
+	if err != nil {
+		return "", errors.Wrap(err, errMakeRequestError)
+	}
+	var r NotificationResourceIDResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.ID, nil
+}
+
+// NotificationWebhooks returns the webhook destinations of the given account.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-list-webhooks
+func (api *API) NotificationWebhooks(accountID string) ([]NotificationWebhook, error) {
+	uri := "/accounts/" + accountID + "/alerting/v3/destinations/webhooks"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []NotificationWebhook{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r NotificationWebhooksResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []NotificationWebhook{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
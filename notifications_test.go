@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationPolicy_Webhook(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/alerting/v3/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "SSL notification for example.com",
+              "enabled": true,
+              "alert_type": "universal_ssl_event_type",
+              "mechanisms": {
+                "webhooks": [{"id": "b115d5ec15c641ee8b7692c449b5227b"}]
+              },
+              "filters": {
+                "zones": ["023e105f4ecef8ad9ca31a8372d0c353"]
+              }
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "0da2b59e-f118-439d-8097-bdfb215203c9"}
+        }`)
+	})
+
+	id, err := client.CreateNotificationPolicy(testAccountID, NotificationPolicy{
+		Name:      "SSL notification for example.com",
+		Enabled:   true,
+		AlertType: "universal_ssl_event_type",
+		Mechanisms: NotificationMechanisms{
+			Webhooks: []NotificationMechanism{{ID: "b115d5ec15c641ee8b7692c449b5227b"}},
+		},
+		Filters: map[string][]string{"zones": {"023e105f4ecef8ad9ca31a8372d0c353"}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0da2b59e-f118-439d-8097-bdfb215203c9", id)
+	}
+}
+
+func TestNotificationWebhooks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/alerting/v3/destinations/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "b115d5ec15c641ee8b7692c449b5227b",
+              "name": "Slack Webhook",
+              "url": "https://hooks.slack.com/services/Ds3fdBFbV/456464Gdd",
+              "type": "slack",
+              "created_at": "2020-10-26T18:25:04.532316Z",
+              "last_success": "2020-10-26T18:25:04.532316Z"
+            }
+          ]
+        }`)
+	})
+
+	webhooks, err := client.NotificationWebhooks(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(webhooks)) {
+			assert.Equal(t, "slack", webhooks[0].Type)
+			assert.NotNil(t, webhooks[0].LastSuccess)
+			assert.Nil(t, webhooks[0].LastFailure)
+		}
+	}
+}
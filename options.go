@@ -55,11 +55,29 @@ func UsingRateLimit(rps float64) Option {
 func UsingRetryPolicy(maxRetries int, minRetryDelaySecs int, maxRetryDelaySecs int) Option {
 	// seconds is very granular for a minimum delay - but this is only in case of failure
 	return func(api *API) error {
-		api.retryPolicy = RetryPolicy{
-			MaxRetries:    maxRetries,
-			MinRetryDelay: time.Duration(minRetryDelaySecs) * time.Second,
-			MaxRetryDelay: time.Duration(maxRetryDelaySecs) * time.Second,
-		}
+		api.retryPolicy.MaxRetries = maxRetries
+		api.retryPolicy.MinRetryDelay = time.Duration(minRetryDelaySecs) * time.Second
+		api.retryPolicy.MaxRetryDelay = time.Duration(maxRetryDelaySecs) * time.Second
+		return nil
+	}
+}
+
+// UsingRetryJitter randomizes the delay before each retry between zero and
+// the exponential backoff of UsingRetryPolicy ("full jitter"), so that many
+// clients failing at once do not retry in lockstep.
+func UsingRetryJitter(enabled bool) Option {
+	return func(api *API) error {
+		api.retryPolicy.Jitter = enabled
+		return nil
+	}
+}
+
+// UsingRetryMaxElapsedTime stops retrying a request once the next retry would
+// start more than d after its first attempt, regardless of the number of
+// retries left. The last error is returned.
+func UsingRetryMaxElapsedTime(d time.Duration) Option {
+	return func(api *API) error {
+		api.retryPolicy.MaxElapsedTime = d
 		return nil
 	}
 }
@@ -73,6 +91,85 @@ func UsingLogger(logger Logger) Option {
 	}
 }
 
+// UsingStrictJSON makes the client fail when a response contains fields that
+// the corresponding response type does not model, instead of silently
+// dropping them. This is useful to catch struct drift in tests.
+//
+// Types with their own JSON decoding, currently CustomHostnameSSL and
+// CustomHostnameBulkResponse, are still decoded leniently: the standard
+// decoder does not pass the setting on to UnmarshalJSON methods.
+func UsingStrictJSON(strict bool) Option {
+	return func(api *API) error {
+		api.strictJSON = strict
+		return nil
+	}
+}
+
+// UsingCustomMetadataLimit changes the maximum size, in bytes, of the JSON
+// encoded custom metadata accepted when creating a custom hostname. A limit
+// of zero or less disables the check. Defaults to 4096 bytes.
+func UsingCustomMetadataLimit(limit int) Option {
+	return func(api *API) error {
+		api.metadataLimit = limit
+		return nil
+	}
+}
+
+// UsingETagCache enables conditional requests for the helpers that support
+// them, currently CustomHostname and FilterCustomHostnames. Responses carrying
+// an ETag are cached in memory and revalidated with If-None-Match; when the
+// API reports no change the cached result is returned with ErrNotModified.
+func UsingETagCache(enabled bool) Option {
+	return func(api *API) error {
+		if enabled {
+			api.etags = &etagCache{entries: make(map[string]etagEntry)}
+		} else {
+			api.etags = nil
+		}
+		return nil
+	}
+}
+
+// UsingMaxResponseBytes limits the size of the response bodies read by the
+// client to n bytes. Larger responses are reported as errors instead of being
+// read into memory. By default the size is not limited.
+func UsingMaxResponseBytes(n int64) Option {
+	return func(api *API) error {
+		api.maxResponseBytes = n
+		return nil
+	}
+}
+
+// UsingRequestSigner sets a RequestSigner which signs every request sent by
+// the client.
+func UsingRequestSigner(signer RequestSigner) Option {
+	return func(api *API) error {
+		api.signer = signer
+		return nil
+	}
+}
+
+// UsingCredentialsProvider sets a CredentialsProvider which is consulted for
+// the credentials of every request, taking precedence over the APIKey,
+// APIEmail and APIUserServiceKey fields.
+func UsingCredentialsProvider(provider CredentialsProvider) Option {
+	return func(api *API) error {
+		api.credentials = provider
+		return nil
+	}
+}
+
+// UsingTimeout bounds every API call made without a context deadline,
+// including its retries and backoff, to d. Calls made with a context that
+// already carries a deadline are not affected. By default calls are not
+// bounded.
+func UsingTimeout(d time.Duration) Option {
+	return func(api *API) error {
+		api.timeout = d
+		return nil
+	}
+}
+
 // parseOptions parses the supplied options functions and returns a configured
 // *API instance.
 func (api *API) parseOptions(opts ...Option) error {
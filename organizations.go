@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
@@ -79,7 +78,7 @@ func (api *API) ListOrganizations() ([]Organization, ResultInfo, error) {
 		return []Organization{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []Organization{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -98,7 +97,7 @@ func (api *API) OrganizationDetails(organizationID string) (OrganizationDetails,
 		return OrganizationDetails{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return OrganizationDetails{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -124,7 +123,7 @@ func (api *API) OrganizationMembers(organizationID string) ([]OrganizationMember
 		return []OrganizationMember{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []OrganizationMember{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -150,7 +149,7 @@ func (api *API) OrganizationInvites(organizationID string) ([]OrganizationInvite
 		return []OrganizationInvite{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []OrganizationInvite{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -176,7 +175,7 @@ func (api *API) OrganizationRoles(organizationID string) ([]OrganizationRole, Re
 		return []OrganizationRole{}, ResultInfo{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []OrganizationRole{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -1,7 +1,7 @@
 package cloudflare
 
 import (
-	"encoding/json"
+	"context"
 	"net/url"
 	"time"
 
@@ -57,7 +57,7 @@ type originCACertificateResponseRevoke struct {
 // API reference: https://api.cloudflare.com/#cloudflare-ca-create-certificate
 func (api *API) CreateOriginCertificate(certificate OriginCACertificate) (*OriginCACertificate, error) {
 	uri := "/certificates"
-	res, err := api.makeRequestWithAuthType("POST", uri, certificate, AuthUserService)
+	res, err := api.makeRequestWithAuthType(context.TODO(), "POST", uri, certificate, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -65,7 +65,7 @@ func (api *API) CreateOriginCertificate(certificate OriginCACertificate) (*Origi
 
 	var originResponse *originCACertificateResponse
 
-	err = json.Unmarshal(res, &originResponse)
+	err = api.unmarshal(res, &originResponse)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
@@ -89,7 +89,7 @@ func (api *API) OriginCertificates(options OriginCACertificateListOptions) ([]Or
 		v.Set("zone_id", options.ZoneID)
 	}
 	uri := "/certificates" + "?" + v.Encode()
-	res, err := api.makeRequestWithAuthType("GET", uri, nil, AuthUserService)
+	res, err := api.makeRequestWithAuthType(context.TODO(), "GET", uri, nil, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -97,7 +97,7 @@ func (api *API) OriginCertificates(options OriginCACertificateListOptions) ([]Or
 
 	var originResponse *originCACertificateResponseList
 
-	err = json.Unmarshal(res, &originResponse)
+	err = api.unmarshal(res, &originResponse)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
@@ -117,7 +117,7 @@ func (api *API) OriginCertificates(options OriginCACertificateListOptions) ([]Or
 // API reference: https://api.cloudflare.com/#cloudflare-ca-certificate-details
 func (api *API) OriginCertificate(certificateID string) (*OriginCACertificate, error) {
 	uri := "/certificates/" + certificateID
-	res, err := api.makeRequestWithAuthType("GET", uri, nil, AuthUserService)
+	res, err := api.makeRequestWithAuthType(context.TODO(), "GET", uri, nil, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -125,7 +125,7 @@ func (api *API) OriginCertificate(certificateID string) (*OriginCACertificate, e
 
 	var originResponse *originCACertificateResponse
 
-	err = json.Unmarshal(res, &originResponse)
+	err = api.unmarshal(res, &originResponse)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
@@ -145,7 +145,7 @@ func (api *API) OriginCertificate(certificateID string) (*OriginCACertificate, e
 // API reference: https://api.cloudflare.com/#cloudflare-ca-revoke-certificate
 func (api *API) RevokeOriginCertificate(certificateID string) (*OriginCACertificateID, error) {
 	uri := "/certificates/" + certificateID
-	res, err := api.makeRequestWithAuthType("DELETE", uri, nil, AuthUserService)
+	res, err := api.makeRequestWithAuthType(context.TODO(), "DELETE", uri, nil, AuthUserService)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
@@ -153,7 +153,7 @@ func (api *API) RevokeOriginCertificate(certificateID string) (*OriginCACertific
 
 	var originResponse *originCACertificateResponseRevoke
 
-	err = json.Unmarshal(res, &originResponse)
+	err = api.unmarshal(res, &originResponse)
 
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
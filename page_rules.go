@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
@@ -111,7 +110,7 @@ func (api *API) CreatePageRule(zoneID string, rule PageRule) (*PageRule, error)
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -128,7 +127,7 @@ func (api *API) ListPageRules(zoneID string) ([]PageRule, error) {
 		return []PageRule{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r PageRulesResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []PageRule{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -145,7 +144,7 @@ func (api *API) PageRule(zoneID, ruleID string) (PageRule, error) {
 		return PageRule{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return PageRule{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -163,7 +162,7 @@ func (api *API) ChangePageRule(zoneID, ruleID string, rule PageRule) error {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
@@ -181,7 +180,7 @@ func (api *API) UpdatePageRule(zoneID, ruleID string, rule PageRule) error {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
@@ -198,7 +197,7 @@ func (api *API) DeletePageRule(zoneID, ruleID string) error {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
@@ -0,0 +1,128 @@
+package cloudflare
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// PaginationOptions can be passed to a list request to configure paging
+// These values will be defaulted if omitted, and PerPage has min/max limits set by resource
+type PaginationOptions struct {
+	Page    int `json:"page,omitempty"`
+	PerPage int `json:"per_page,omitempty"`
+	// Order names the field to sort by and Direction is either "asc" or
+	// "desc". Not every resource supports sorting.
+	Order     string `json:"order,omitempty"`
+	Direction string `json:"direction,omitempty"`
+}
+
+// encode returns the query string parameters for the options, leaving out
+// those which are unset. PerPage is clamped to maxPerPage, the largest page
+// size accepted by the resource being listed.
+func (p PaginationOptions) encode(maxPerPage int) url.Values {
+	v := url.Values{}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage > 0 {
+		perPage := p.PerPage
+		if maxPerPage > 0 && perPage > maxPerPage {
+			perPage = maxPerPage
+		}
+		v.Set("per_page", strconv.Itoa(perPage))
+	}
+	if p.Order != "" {
+		v.Set("order", p.Order)
+	}
+	if p.Direction != "" {
+		v.Set("direction", p.Direction)
+	}
+	return v
+}
+
+// forEachPage requests uri for every page of a page number paginated
+// endpoint, starting at opts.Page or the first page, and passes each response
+// body to page, which decodes it and returns its ResultInfo. PerPage is
+// clamped to maxPerPage as by encode. Pages are requested up to
+// result_info.total_pages; for endpoints which do not report a total, until
+// a page holds fewer than PerPage results, as given by the returned Count.
+func (api *API) forEachPage(uri string, opts PaginationOptions, maxPerPage int, page func([]byte) (ResultInfo, error)) error {
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	perPage := opts.PerPage
+	if maxPerPage > 0 && perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	for {
+		res, err := api.makeRequest("GET", uri+"?"+opts.encode(maxPerPage).Encode(), nil)
+		if err != nil {
+			return errors.Wrap(err, errMakeRequestError)
+		}
+		resultInfo, err := page(res)
+		if err != nil {
+			return err
+		}
+		if resultInfo.TotalPages > 0 {
+			if opts.Page >= resultInfo.TotalPages {
+				return nil
+			}
+		} else if resultInfo.Count == 0 || resultInfo.Count < perPage {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+// nextCursor returns the cursor of the page following the current one, or
+// of the page preceding it if backward is set. An empty cursor means there
+// is no such page.
+func (r ResultInfo) nextCursor(backward bool) string {
+	if backward {
+		return r.Cursors.Before
+	}
+	if r.Cursors.After != "" {
+		return r.Cursors.After
+	}
+	return r.Cursor
+}
+
+// forEachCursorPage requests uri with the query values v for every page of a
+// cursor paginated endpoint, passing each response body to page, which
+// decodes it and returns its ResultInfo. Pages are followed through
+// result_info.cursors.after, or through result_info.cursors.before if
+// backward is set, by setting the cursor query parameter until no further
+// cursor is returned. v may hold a starting cursor.
+func (api *API) forEachCursorPage(uri string, v url.Values, backward bool, page func([]byte) (ResultInfo, error)) error {
+	if v == nil {
+		v = url.Values{}
+	}
+	seen := make(map[string]bool)
+	for {
+		u := uri
+		if len(v) > 0 {
+			u += "?" + v.Encode()
+		}
+		res, err := api.makeRequest("GET", u, nil)
+		if err != nil {
+			return errors.Wrap(err, errMakeRequestError)
+		}
+		resultInfo, err := page(res)
+		if err != nil {
+			return err
+		}
+		cursor := resultInfo.nextCursor(backward)
+		if cursor == "" {
+			return nil
+		}
+		// Guard against an endpoint handing back a cursor already followed,
+		// which would otherwise loop forever.
+		if seen[cursor] {
+			return errors.Errorf("cursor %q was returned twice", cursor)
+		}
+		seen[cursor] = true
+		v.Set("cursor", cursor)
+	}
+}
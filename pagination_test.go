@@ -0,0 +1,169 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginationOptions_Encode(t *testing.T) {
+	opts := PaginationOptions{Page: 2, PerPage: 25, Order: "name", Direction: "desc"}
+	assert.Equal(t, "direction=desc&order=name&page=2&per_page=25", opts.encode(50).Encode())
+
+	assert.Equal(t, "", PaginationOptions{}.encode(50).Encode())
+}
+
+func TestPaginationOptions_EncodeClampsPerPage(t *testing.T) {
+	assert.Equal(t, "50", PaginationOptions{PerPage: 1000}.encode(50).Get("per_page"))
+	assert.Equal(t, "1000", PaginationOptions{PerPage: 1000}.encode(0).Get("per_page"))
+	assert.Equal(t, "", PaginationOptions{PerPage: -1}.encode(50).Get("per_page"))
+}
+
+// handleCursorPages serves three pages of a cursor paginated endpoint,
+// linked both ways through result_info.cursors.
+func handleCursorPages(t *testing.T, cursors *[]string) {
+	mux.HandleFunc("/accounts/"+testAccountID+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		cursor := r.URL.Query().Get("cursor")
+		*cursors = append(*cursors, cursor)
+
+		var before, after string
+		switch cursor {
+		case "", "p1":
+			cursor, after = "p1", "p2"
+		case "p2":
+			before, after = "p1", "p3"
+		case "p3":
+			before = "p2"
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [{"id": "%s"}],
+          "result_info": {"cursors": {"before": "%s", "after": "%s"}}
+        }`, cursor, before, after)
+	})
+}
+
+func cursorPageIDs(ids *[]string) func([]byte) (ResultInfo, error) {
+	return func(res []byte) (ResultInfo, error) {
+		var r struct {
+			Response
+			Result     []struct{ ID string }
+			ResultInfo `json:"result_info"`
+		}
+		if err := client.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, err
+		}
+		for _, item := range r.Result {
+			*ids = append(*ids, item.ID)
+		}
+		return r.ResultInfo, nil
+	}
+}
+
+func TestForEachCursorPage_After(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors, ids []string
+	handleCursorPages(t, &cursors)
+
+	err := client.forEachCursorPage("/accounts/"+testAccountID+"/logs", nil, false, cursorPageIDs(&ids))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"", "p2", "p3"}, cursors)
+		assert.Equal(t, []string{"p1", "p2", "p3"}, ids)
+	}
+}
+
+func TestForEachCursorPage_Before(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors, ids []string
+	handleCursorPages(t, &cursors)
+
+	err := client.forEachCursorPage("/accounts/"+testAccountID+"/logs", url.Values{"cursor": {"p3"}}, true, cursorPageIDs(&ids))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"p3", "p2", "p1"}, cursors)
+		assert.Equal(t, []string{"p3", "p2", "p1"}, ids)
+	}
+}
+
+func TestForEachCursorPage_RepeatedCursor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/accounts/"+testAccountID+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [], "result_info": {"cursors": {"after": "again"}}}`)
+	})
+
+	var ids []string
+	err := client.forEachCursorPage("/accounts/"+testAccountID+"/logs", nil, false, cursorPageIDs(&ids))
+	assert.EqualError(t, err, `cursor "again" was returned twice`)
+	assert.Equal(t, 2, requests)
+}
+
+func TestResultInfo_NextCursor(t *testing.T) {
+	assert.Equal(t, "a", ResultInfo{Cursor: "c", Cursors: ResultInfoCursors{Before: "b", After: "a"}}.nextCursor(false))
+	assert.Equal(t, "b", ResultInfo{Cursor: "c", Cursors: ResultInfoCursors{Before: "b", After: "a"}}.nextCursor(true))
+	assert.Equal(t, "c", ResultInfo{Cursor: "c"}.nextCursor(false))
+	assert.Equal(t, "", ResultInfo{Cursor: "c"}.nextCursor(true))
+}
+
+func TestForEachPage_TotalPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []string
+	mux.HandleFunc("/accounts/"+testAccountID+"/items", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [{"id": "%s"}],
+          "result_info": {"page": %s, "per_page": 2, "count": 1, "total_count": 3, "total_pages": 3}
+        }`, page, page)
+	})
+
+	var ids []string
+	err := client.forEachPage("/accounts/"+testAccountID+"/items", PaginationOptions{PerPage: 5}, 2, cursorPageIDs(&ids))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"1", "2", "3"}, pages)
+		assert.Equal(t, []string{"1", "2", "3"}, ids)
+	}
+}
+
+func TestForEachPage_ShortPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var pages []string
+	mux.HandleFunc("/accounts/"+testAccountID+"/items", func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	count := 2
+	err := client.forEachPage("/accounts/"+testAccountID+"/items", PaginationOptions{PerPage: 2}, 2, func(res []byte) (ResultInfo, error) {
+		count--
+		return ResultInfo{Count: 2 * count}, nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"1", "2"}, pages)
+	}
+}
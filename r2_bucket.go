@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// R2 location hints for bucket creation. The bucket is placed close to the
+// hinted region where possible.
+const (
+	R2LocationWesternNorthAmerica = "wnam"
+	R2LocationEasternNorthAmerica = "enam"
+	R2LocationWesternEurope       = "weur"
+	R2LocationEasternEurope       = "eeur"
+	R2LocationAsiaPacific         = "apac"
+)
+
+// R2Bucket describes an R2 storage bucket.
+type R2Bucket struct {
+	Name         string     `json:"name"`
+	CreationDate *time.Time `json:"creation_date,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// R2BucketResponse represents the response from the R2 bucket endpoints
+// containing a single bucket.
+type R2BucketResponse struct {
+	Response
+	Result R2Bucket `json:"result"`
+}
+
+// R2BucketListResponse represents the response from the list R2 buckets
+// endpoint.
+type R2BucketListResponse struct {
+	Response
+	Result struct {
+		Buckets []R2Bucket `json:"buckets"`
+	} `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// CreateR2Bucket creates a new R2 bucket in the given account. location is an
+// optional hint, one of the R2Location constants, and may be empty.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-create-bucket
+func (api *API) CreateR2Bucket(accountID, name, location string) (R2Bucket, error) {
+	if name == "" {
+		return R2Bucket{}, errors.New("bucket name cannot be empty")
+	}
+	uri := "/accounts/" + accountID + "/r2/buckets"
+	res, err := api.makeRequest("POST", uri, struct {
+		Name         string `json:"name"`
+		LocationHint string `json:"locationHint,omitempty"`
+	}{name, location})
+	if err != nil {
+		return R2Bucket{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r R2BucketResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return R2Bucket{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListR2Buckets returns all R2 buckets of the given account, following the
+// result cursor until the last page.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-list-buckets
+func (api *API) ListR2Buckets(accountID string) ([]R2Bucket, error) {
+	var buckets []R2Bucket
+	uri := "/accounts/" + accountID + "/r2/buckets"
+	err := api.forEachCursorPage(uri, nil, false, func(res []byte) (ResultInfo, error) {
+		var r R2BucketListResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		buckets = append(buckets, r.Result.Buckets...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []R2Bucket{}, err
+	}
+	return buckets, nil
+}
+
+// DeleteR2Bucket deletes an R2 bucket. The bucket must be empty.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-delete-bucket
+func (api *API) DeleteR2Bucket(accountID, name string) error {
+	if name == "" {
+		return errors.New("bucket name cannot be empty")
+	}
+	uri := "/accounts/" + accountID + "/r2/buckets/" + name
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
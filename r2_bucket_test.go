@@ -0,0 +1,110 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateR2Bucket(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/r2/buckets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "example-bucket", "locationHint": "weur"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "name": "example-bucket",
+            "creation_date": "2022-06-24T19:58:49.477Z",
+            "location": "WEUR"
+          }
+        }`)
+	})
+
+	created, _ := time.Parse(time.RFC3339, "2022-06-24T19:58:49.477Z")
+	want := R2Bucket{
+		Name:         "example-bucket",
+		CreationDate: &created,
+		Location:     "WEUR",
+	}
+
+	actual, err := client.CreateR2Bucket(testAccountID, "example-bucket", R2LocationWesternEurope)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.CreateR2Bucket(testAccountID, "", "")
+	assert.Error(t, err)
+}
+
+func TestListR2Buckets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/r2/buckets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": {
+                "buckets": [
+                  {"name": "example-bucket", "creation_date": "2022-06-24T19:58:49.477Z", "location": "WEUR"}
+                ]
+              },
+              "result_info": {"cursor": "ZXhhbXBsZS1idWNrZXQ", "per_page": 1}
+            }`)
+			return
+		}
+		assert.Equal(t, "ZXhhbXBsZS1idWNrZXQ", r.URL.Query().Get("cursor"))
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "buckets": [
+              {"name": "logs", "creation_date": "2022-07-01T08:00:00Z", "location": "ENAM"}
+            ]
+          },
+          "result_info": {"per_page": 1}
+        }`)
+	})
+
+	buckets, err := client.ListR2Buckets(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 2, len(buckets)) {
+			assert.Equal(t, "example-bucket", buckets[0].Name)
+			assert.Equal(t, "WEUR", buckets[0].Location)
+			assert.Equal(t, "logs", buckets[1].Name)
+			assert.Equal(t, "ENAM", buckets[1].Location)
+		}
+	}
+}
+
+func TestDeleteR2Bucket(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/r2/buckets/example-bucket", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	assert.NoError(t, client.DeleteR2Bucket(testAccountID, "example-bucket"))
+}
@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"net/url"
 	"time"
 
@@ -60,7 +59,7 @@ func (api *API) CreateRailgun(name string) (Railgun, error) {
 		return Railgun{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r railgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return Railgun{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -80,7 +79,7 @@ func (api *API) ListRailguns(options RailgunListOptions) ([]Railgun, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r railgunsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -96,7 +95,7 @@ func (api *API) RailgunDetails(railgunID string) (Railgun, error) {
 		return Railgun{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r railgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return Railgun{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -112,7 +111,7 @@ func (api *API) RailgunZones(railgunID string) ([]Zone, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r ZonesResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -133,7 +132,7 @@ func (api *API) enableRailgun(railgunID string, enable bool) (Railgun, error) {
 		return Railgun{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r railgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return Railgun{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -223,7 +222,7 @@ func (api *API) ZoneRailguns(zoneID string) ([]ZoneRailgun, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneRailgunsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -239,7 +238,7 @@ func (api *API) ZoneRailgunDetails(zoneID, railgunID string) (ZoneRailgun, error
 		return ZoneRailgun{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneRailgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return ZoneRailgun{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -255,7 +254,7 @@ func (api *API) TestRailgunConnection(zoneID, railgunID string) (RailgunDiagnosi
 		return RailgunDiagnosis{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r railgunDiagnosisResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return RailgunDiagnosis{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -276,7 +275,7 @@ func (api *API) connectZoneRailgun(zoneID, railgunID string, connect bool) (Zone
 		return ZoneRailgun{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneRailgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return ZoneRailgun{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -1,10 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
-	"net/url"
-	"strconv"
-
 	"github.com/pkg/errors"
 )
 
@@ -79,7 +75,7 @@ func (api *API) CreateRateLimit(zoneID string, limit RateLimit) (RateLimit, erro
 		return RateLimit{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r rateLimitResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return RateLimit{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -89,13 +85,7 @@ func (api *API) CreateRateLimit(zoneID string, limit RateLimit) (RateLimit, erro
 //
 // API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-list-rate-limits
 func (api *API) ListRateLimits(zoneID string, pageOpts PaginationOptions) ([]RateLimit, ResultInfo, error) {
-	v := url.Values{}
-	if pageOpts.PerPage > 0 {
-		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
-	}
-	if pageOpts.Page > 0 {
-		v.Set("page", strconv.Itoa(pageOpts.Page))
-	}
+	v := pageOpts.encode(100)
 
 	uri := "/zones/" + zoneID + "/rate_limits"
 	if len(v) > 0 {
@@ -108,7 +98,7 @@ func (api *API) ListRateLimits(zoneID string, pageOpts PaginationOptions) ([]Rat
 	}
 
 	var r rateLimitListResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []RateLimit{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -154,7 +144,7 @@ func (api *API) RateLimit(zoneID, limitID string) (RateLimit, error) {
 		return RateLimit{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r rateLimitResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return RateLimit{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -171,7 +161,7 @@ func (api *API) UpdateRateLimit(zoneID, limitID string, limit RateLimit) (RateLi
 		return RateLimit{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r rateLimitResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return RateLimit{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -187,7 +177,7 @@ func (api *API) DeleteRateLimit(zoneID, limitID string) error {
 		return errors.Wrap(err, errMakeRequestError)
 	}
 	var r rateLimitResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RegistrarDomain describes a domain registered through Cloudflare
+// Registrar.
+type RegistrarDomain struct {
+	ID                string                  `json:"id"`
+	Name              string                  `json:"name"`
+	CurrentRegistrar  string                  `json:"current_registrar,omitempty"`
+	Available         bool                    `json:"available"`
+	SupportedTLD      bool                    `json:"supported_tld"`
+	CanRegister       bool                    `json:"can_register"`
+	AutoRenew         bool                    `json:"auto_renew"`
+	Locked            bool                    `json:"locked"`
+	Privacy           bool                    `json:"privacy"`
+	ExpiresAt         *time.Time              `json:"expires_at,omitempty"`
+	CreatedAt         *time.Time              `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time              `json:"updated_at,omitempty"`
+	RegistrantContact *RegistrarDomainContact `json:"registrant_contact,omitempty"`
+}
+
+// RegistrarDomainContact describes the registrant of a domain.
+type RegistrarDomainContact struct {
+	ID           string `json:"id,omitempty"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Organization string `json:"organization,omitempty"`
+	Address      string `json:"address"`
+	Address2     string `json:"address2,omitempty"`
+	City         string `json:"city"`
+	State        string `json:"state"`
+	Zip          string `json:"zip"`
+	Country      string `json:"country"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`
+	Fax          string `json:"fax,omitempty"`
+}
+
+// RegistrarDomainConfiguration lists the settings of a domain to change.
+// Fields left nil keep their current value.
+type RegistrarDomainConfiguration struct {
+	AutoRenew   *bool    `json:"auto_renew,omitempty"`
+	Locked      *bool    `json:"locked,omitempty"`
+	Privacy     *bool    `json:"privacy,omitempty"`
+	NameServers []string `json:"name_servers,omitempty"`
+}
+
+// RegistrarDomainResponse represents the response from the Registrar
+// endpoints containing a single domain.
+type RegistrarDomainResponse struct {
+	Response
+	Result RegistrarDomain `json:"result"`
+}
+
+// RegistrarDomainsResponse represents the response from the list Registrar
+// domains endpoint.
+type RegistrarDomainsResponse struct {
+	Response
+	Result []RegistrarDomain `json:"result"`
+}
+
+// RegistrarDomains returns the domains registered in the given account.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-list-domains
+func (api *API) RegistrarDomains(accountID string) ([]RegistrarDomain, error) {
+	uri := "/accounts/" + accountID + "/registrar/domains"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RegistrarDomainsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []RegistrarDomain{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// RegistrarDomain returns the given domain of the account.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-get-domain
+func (api *API) RegistrarDomain(accountID, domainName string) (RegistrarDomain, error) {
+	uri := "/accounts/" + accountID + "/registrar/domains/" + domainName
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RegistrarDomainResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return RegistrarDomain{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateRegistrarDomain changes the configuration of the given domain, e.g.
+// whether it is renewed automatically or locked against transfers.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-update-domain
+func (api *API) UpdateRegistrarDomain(accountID, domainName string, conf RegistrarDomainConfiguration) (RegistrarDomain, error) {
+	uri := "/accounts/" + accountID + "/registrar/domains/" + domainName
+	res, err := api.makeRequest("PUT", uri, conf)
+	if err != nil {
+		return RegistrarDomain{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RegistrarDomainResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return RegistrarDomain{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,96 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistrarDomains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/registrar/domains", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "ea95132c15732412d22c1476fa83f27a",
+              "name": "cloudflare.com",
+              "current_registrar": "Cloudflare",
+              "available": false,
+              "supported_tld": true,
+              "can_register": false,
+              "auto_renew": true,
+              "locked": false,
+              "privacy": true,
+              "expires_at": "2019-08-28T23:59:59Z",
+              "created_at": "2018-08-28T17:26:26Z",
+              "updated_at": "2018-08-28T17:26:26Z",
+              "registrant_contact": {
+                "id": "ea95132c15732412d22c1476fa83f27a",
+                "first_name": "John",
+                "last_name": "Appleseed",
+                "address": "123 Sesame St.",
+                "city": "Austin",
+                "state": "TX",
+                "zip": "12345",
+                "country": "US",
+                "phone": "+1 123-123-1234",
+                "email": "user@example.com"
+              }
+            }
+          ]
+        }`)
+	})
+
+	domains, err := client.RegistrarDomains(testAccountID)
+	if assert.NoError(t, err) {
+		if assert.Equal(t, 1, len(domains)) {
+			assert.Equal(t, "cloudflare.com", domains[0].Name)
+			assert.True(t, domains[0].AutoRenew)
+			assert.NotNil(t, domains[0].ExpiresAt)
+			assert.Equal(t, "Appleseed", domains[0].RegistrantContact.LastName)
+		}
+	}
+}
+
+func TestUpdateRegistrarDomain_AutoRenew(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/registrar/domains/cloudflare.com", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"auto_renew": false}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "ea95132c15732412d22c1476fa83f27a",
+            "name": "cloudflare.com",
+            "auto_renew": false,
+            "locked": true
+          }
+        }`)
+	})
+
+	autoRenew := false
+	domain, err := client.UpdateRegistrarDomain(testAccountID, "cloudflare.com", RegistrarDomainConfiguration{AutoRenew: &autoRenew})
+	if assert.NoError(t, err) {
+		assert.False(t, domain.AutoRenew)
+		assert.True(t, domain.Locked)
+	}
+}
@@ -0,0 +1,650 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Ruleset kinds.
+const (
+	RulesetKindCustom  = "custom"
+	RulesetKindManaged = "managed"
+	RulesetKindRoot    = "root"
+	RulesetKindZone    = "zone"
+)
+
+// Ruleset phases.
+const (
+	RulesetPhaseHTTPRequestTransform         = "http_request_transform"
+	RulesetPhaseHTTPRequestLateTransform     = "http_request_late_transform"
+	RulesetPhaseHTTPResponseHeadersTransform = "http_response_headers_transform"
+	RulesetPhaseHTTPRequestOrigin            = "http_request_origin"
+	RulesetPhaseHTTPRequestFirewallCustom    = "http_request_firewall_custom"
+	RulesetPhaseHTTPRequestFirewallManaged   = "http_request_firewall_managed"
+	RulesetPhaseHTTPRatelimit                = "http_ratelimit"
+	RulesetPhaseHTTPRequestCacheSettings     = "http_request_cache_settings"
+)
+
+// Ruleset rule actions.
+const (
+	RulesetRuleActionBlock            = "block"
+	RulesetRuleActionChallenge        = "challenge"
+	RulesetRuleActionExecute          = "execute"
+	RulesetRuleActionLog              = "log"
+	RulesetRuleActionRewrite          = "rewrite"
+	RulesetRuleActionRoute            = "route"
+	RulesetRuleActionSetCacheSettings = "set_cache_settings"
+	RulesetRuleActionSkip             = "skip"
+)
+
+// RulesetIDCloudflareManaged is the ID of the Cloudflare Managed Ruleset.
+const RulesetIDCloudflareManaged = "efb7b8c949ac4650a09736fc376e9aee"
+
+// Ruleset describes a collection of rules which are evaluated in a single
+// phase of the Rulesets engine.
+type Ruleset struct {
+	ID          string        `json:"id,omitempty"`
+	Name        string        `json:"name,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Kind        string        `json:"kind,omitempty"`
+	Version     string        `json:"version,omitempty"`
+	LastUpdated *time.Time    `json:"last_updated,omitempty"`
+	Phase       string        `json:"phase,omitempty"`
+	Rules       []RulesetRule `json:"rules"`
+}
+
+// RulesetRule describes a single rule within a ruleset.
+type RulesetRule struct {
+	ID               string                       `json:"id,omitempty"`
+	Version          string                       `json:"version,omitempty"`
+	Action           string                       `json:"action"`
+	ActionParameters *RulesetRuleActionParameters `json:"action_parameters,omitempty"`
+	Expression       string                       `json:"expression"`
+	Description      string                       `json:"description,omitempty"`
+	LastUpdated      *time.Time                   `json:"last_updated,omitempty"`
+	Ref              string                       `json:"ref,omitempty"`
+	// Enabled is a pointer as the API treats an omitted value as enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// RulesetRuleActionParameters holds the action specific configuration of a
+// ruleset rule.
+type RulesetRuleActionParameters struct {
+	// ID and Version identify the ruleset run by an execute rule.
+	ID         string                                           `json:"id,omitempty"`
+	Version    string                                           `json:"version,omitempty"`
+	Overrides  *RulesetRuleActionParametersOverrides            `json:"overrides,omitempty"`
+	URI        *RulesetRuleActionParametersURI                  `json:"uri,omitempty"`
+	Headers    map[string]RulesetRuleActionParametersHTTPHeader `json:"headers,omitempty"`
+	Origin     *RulesetRuleActionParametersOrigin               `json:"origin,omitempty"`
+	HostHeader string                                           `json:"host_header,omitempty"`
+	SNI        *RulesetRuleActionParametersSNI                  `json:"sni,omitempty"`
+	// Cache, EdgeTTL, BrowserTTL and CacheKey configure a
+	// set_cache_settings rule.
+	Cache      *bool                                  `json:"cache,omitempty"`
+	EdgeTTL    *RulesetRuleActionParametersEdgeTTL    `json:"edge_ttl,omitempty"`
+	BrowserTTL *RulesetRuleActionParametersBrowserTTL `json:"browser_ttl,omitempty"`
+	CacheKey   *RulesetRuleActionParametersCacheKey   `json:"cache_key,omitempty"`
+	// Ruleset, Rulesets, Rules and Phases select what a skip rule skips:
+	// the remainder of the current ruleset when Ruleset is "current", whole
+	// rulesets by ID, individual rules keyed by the ID of their ruleset, or
+	// whole phases.
+	Ruleset  string              `json:"ruleset,omitempty"`
+	Rulesets []string            `json:"rulesets,omitempty"`
+	Rules    map[string][]string `json:"rules,omitempty"`
+	Phases   []string            `json:"phases,omitempty"`
+}
+
+// RulesetRuleActionParametersOverrides changes the behaviour of the ruleset
+// run by an execute rule, either as a whole or for some of its rules.
+type RulesetRuleActionParametersOverrides struct {
+	Enabled    *bool                                      `json:"enabled,omitempty"`
+	Action     string                                     `json:"action,omitempty"`
+	Categories []RulesetRuleActionParametersCategories    `json:"categories,omitempty"`
+	Rules      []RulesetRuleActionParametersOverridesRule `json:"rules,omitempty"`
+}
+
+// RulesetRuleActionParametersCategories overrides the rules of an executed
+// ruleset carrying the given tag.
+type RulesetRuleActionParametersCategories struct {
+	Category string `json:"category"`
+	Action   string `json:"action,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+}
+
+// RulesetRuleActionParametersOverridesRule overrides a single rule of an
+// executed ruleset.
+type RulesetRuleActionParametersOverridesRule struct {
+	ID               string `json:"id"`
+	Action           string `json:"action,omitempty"`
+	Enabled          *bool  `json:"enabled,omitempty"`
+	ScoreThreshold   int    `json:"score_threshold,omitempty"`
+	SensitivityLevel string `json:"sensitivity_level,omitempty"`
+}
+
+// RulesetRuleActionParametersURI describes a URI rewrite of a transform rule.
+type RulesetRuleActionParametersURI struct {
+	Path  *RulesetRuleActionParametersURIPath  `json:"path,omitempty"`
+	Query *RulesetRuleActionParametersURIQuery `json:"query,omitempty"`
+}
+
+// RulesetRuleActionParametersURIPath describes the new path of a URI rewrite.
+// Only one of Value or Expression should be set.
+type RulesetRuleActionParametersURIPath struct {
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersURIQuery describes the new query string of a URI
+// rewrite. Only one of Value or Expression should be set.
+type RulesetRuleActionParametersURIQuery struct {
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersHTTPHeader describes a header modification.
+//
+// Operation is one of "set", "add" or "remove". Value and Expression are
+// ignored when removing a header.
+type RulesetRuleActionParametersHTTPHeader struct {
+	Operation  string `json:"operation"`
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersOrigin overrides the origin of an origin rule.
+type RulesetRuleActionParametersOrigin struct {
+	Host string `json:"host,omitempty"`
+	Port int    `json:"port,omitempty"`
+}
+
+// RulesetRuleActionParametersSNI overrides the SNI sent to the origin.
+type RulesetRuleActionParametersSNI struct {
+	Value string `json:"value"`
+}
+
+// RulesetRuleActionParametersEdgeTTL sets how long Cloudflare caches matching
+// responses. Mode is "respect_origin", "override_origin" or "bypass_by_default";
+// Default is the TTL in seconds when overriding the origin.
+type RulesetRuleActionParametersEdgeTTL struct {
+	Mode    string `json:"mode,omitempty"`
+	Default int    `json:"default,omitempty"`
+}
+
+// RulesetRuleActionParametersBrowserTTL sets how long browsers cache matching
+// responses. Mode is "respect_origin", "override_origin" or "bypass";
+// Default is the TTL in seconds when overriding the origin.
+type RulesetRuleActionParametersBrowserTTL struct {
+	Mode    string `json:"mode,omitempty"`
+	Default int    `json:"default,omitempty"`
+}
+
+// RulesetRuleActionParametersCacheKey controls the cache key of matching
+// requests.
+type RulesetRuleActionParametersCacheKey struct {
+	CacheByDeviceType       bool                                  `json:"cache_by_device_type,omitempty"`
+	IgnoreQueryStringsOrder bool                                  `json:"ignore_query_strings_order,omitempty"`
+	CacheDeceptionArmor     bool                                  `json:"cache_deception_armor,omitempty"`
+	CustomKey               *RulesetRuleActionParametersCustomKey `json:"custom_key,omitempty"`
+}
+
+// RulesetRuleActionParametersCustomKey selects the parts of a request making
+// up its cache key.
+type RulesetRuleActionParametersCustomKey struct {
+	Query *RulesetRuleActionParametersCustomKeyQuery `json:"query_string,omitempty"`
+}
+
+// RulesetRuleActionParametersCustomKeyQuery selects the query string
+// parameters included in, or excluded from, the cache key. Only one of
+// Include or Exclude should be set.
+type RulesetRuleActionParametersCustomKeyQuery struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// RulesetResponse represents the response from the ruleset endpoints
+// containing a single ruleset.
+type RulesetResponse struct {
+	Response
+	Result Ruleset `json:"result"`
+}
+
+// ListRulesetResponse represents the response from the list rulesets
+// endpoint.
+type ListRulesetResponse struct {
+	Response
+	Result []Ruleset `json:"result"`
+}
+
+// CreateRuleset creates a new ruleset for the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-create-a-zone-ruleset
+func (api *API) CreateRuleset(zoneID string, rs Ruleset) (Ruleset, error) {
+	return api.createRuleset("/zones/"+zoneID, rs)
+}
+
+// ListRulesets lists the rulesets of the given zone. Rules are not included
+// in the listing; use GetRuleset to fetch them.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-list-zone-rulesets
+func (api *API) ListRulesets(zoneID string) ([]Ruleset, error) {
+	return api.listRulesets("/zones/" + zoneID)
+}
+
+// GetRuleset fetches the latest version of a ruleset, including its rules.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-get-a-zone-ruleset
+func (api *API) GetRuleset(zoneID, rulesetID string) (Ruleset, error) {
+	return api.getRuleset("/zones/"+zoneID, rulesetID)
+}
+
+// UpdateRuleset replaces the description and rules of the given ruleset.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-update-a-zone-ruleset
+func (api *API) UpdateRuleset(zoneID, rulesetID string, rs Ruleset) (Ruleset, error) {
+	return api.updateRuleset("/zones/"+zoneID, rulesetID, rs)
+}
+
+// DeleteRuleset deletes the given ruleset and all of its versions.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-delete-a-zone-ruleset
+func (api *API) DeleteRuleset(zoneID, rulesetID string) error {
+	return api.deleteRuleset("/zones/"+zoneID, rulesetID)
+}
+
+// GetEntrypointRuleset fetches the zone's entrypoint ruleset for the given
+// phase, including its rules.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-get-a-zone-entry-point-ruleset
+func (api *API) GetEntrypointRuleset(zoneID, phase string) (Ruleset, error) {
+	return api.getRuleset("/zones/"+zoneID, "phases/"+phase+"/entrypoint")
+}
+
+// UpdateEntrypointRuleset replaces the rules of the zone's entrypoint ruleset
+// for the given phase, creating the entrypoint if it does not exist yet.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-update-a-zone-entry-point-ruleset
+func (api *API) UpdateEntrypointRuleset(zoneID, phase string, rs Ruleset) (Ruleset, error) {
+	return api.updateEntrypointRuleset("/zones/"+zoneID, phase, rs)
+}
+
+// CreateAccountRuleset creates a new ruleset for the given account, e.g. a
+// custom WAF ruleset deployed to all zones of an Enterprise account.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-create-an-account-ruleset
+func (api *API) CreateAccountRuleset(accountID string, rs Ruleset) (Ruleset, error) {
+	return api.createRuleset("/accounts/"+accountID, rs)
+}
+
+// ListAccountRulesets lists the rulesets of the given account. Rules are not
+// included in the listing; use GetAccountRuleset to fetch them.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-list-account-rulesets
+func (api *API) ListAccountRulesets(accountID string) ([]Ruleset, error) {
+	return api.listRulesets("/accounts/" + accountID)
+}
+
+// GetAccountRuleset fetches the latest version of an account ruleset,
+// including its rules.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-get-an-account-ruleset
+func (api *API) GetAccountRuleset(accountID, rulesetID string) (Ruleset, error) {
+	return api.getRuleset("/accounts/"+accountID, rulesetID)
+}
+
+// UpdateAccountRuleset replaces the description and rules of the given
+// account ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-ruleset
+func (api *API) UpdateAccountRuleset(accountID, rulesetID string, rs Ruleset) (Ruleset, error) {
+	return api.updateRuleset("/accounts/"+accountID, rulesetID, rs)
+}
+
+// DeleteAccountRuleset deletes the given account ruleset and all of its
+// versions.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-delete-an-account-ruleset
+func (api *API) DeleteAccountRuleset(accountID, rulesetID string) error {
+	return api.deleteRuleset("/accounts/"+accountID, rulesetID)
+}
+
+// UpdateAccountEntrypointRuleset replaces the rules of the account's
+// entrypoint ruleset for the given phase, creating the entrypoint if it does
+// not exist yet. Its rules apply to the zones of the account.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-entry-point-ruleset
+func (api *API) UpdateAccountEntrypointRuleset(accountID, phase string, rs Ruleset) (Ruleset, error) {
+	return api.updateEntrypointRuleset("/accounts/"+accountID, phase, rs)
+}
+
+// DeployManagedWAFRuleset deploys the Cloudflare Managed Ruleset to the given
+// zone, applying the given rule overrides. The zone's
+// http_request_firewall_managed entrypoint is replaced by a single rule
+// executing the managed ruleset for all traffic.
+//
+// API reference: https://developers.cloudflare.com/waf/managed-rules/deploy-zone-dashboard/
+func (api *API) DeployManagedWAFRuleset(zoneID string, overrides []RulesetRuleActionParametersOverridesRule) (Ruleset, error) {
+	return api.DeployManagedWAFRulesetWithExceptions(zoneID, nil, overrides)
+}
+
+// ManagedWAFException describes trusted traffic, matching Expression, for
+// which some or all managed rules are skipped. At least one of Current,
+// Rulesets, Rules or Phases has to be set.
+type ManagedWAFException struct {
+	Expression  string
+	Description string
+	// Current skips the remaining rules of the entrypoint, i.e. every
+	// managed ruleset deployed after the exception.
+	Current bool
+	// Rulesets lists the IDs of the rulesets skipped entirely.
+	Rulesets []string
+	// Rules maps the ID of a ruleset to the IDs of its rules to skip.
+	Rules map[string][]string
+	// Phases lists the phases skipped entirely.
+	Phases []string
+}
+
+// Rule returns the skip rule implementing the exception.
+func (e ManagedWAFException) Rule() (RulesetRule, error) {
+	if e.Expression == "" {
+		return RulesetRule{}, errors.New("exception expression cannot be empty")
+	}
+	if !e.Current && len(e.Rulesets) == 0 && len(e.Rules) == 0 && len(e.Phases) == 0 {
+		return RulesetRule{}, errors.New("exception must skip the current ruleset, rulesets, rules or phases")
+	}
+	params := &RulesetRuleActionParameters{
+		Rulesets: e.Rulesets,
+		Rules:    e.Rules,
+		Phases:   e.Phases,
+	}
+	if e.Current {
+		params.Ruleset = "current"
+	}
+	return RulesetRule{
+		Action:           RulesetRuleActionSkip,
+		ActionParameters: params,
+		Expression:       e.Expression,
+		Description:      e.Description,
+	}, nil
+}
+
+// DeployManagedWAFRulesetWithExceptions is like DeployManagedWAFRuleset, but
+// the entrypoint starts with a skip rule for each of the given exceptions, in
+// order, ahead of the rule executing the managed ruleset.
+//
+// API reference: https://developers.cloudflare.com/waf/managed-rules/waf-exceptions/
+func (api *API) DeployManagedWAFRulesetWithExceptions(zoneID string, exceptions []ManagedWAFException, overrides []RulesetRuleActionParametersOverridesRule) (Ruleset, error) {
+	var rules []RulesetRule
+	for i, e := range exceptions {
+		rule, err := e.Rule()
+		if err != nil {
+			return Ruleset{}, errors.Wrapf(err, "invalid exception %d", i)
+		}
+		rules = append(rules, rule)
+	}
+
+	params := &RulesetRuleActionParameters{ID: RulesetIDCloudflareManaged}
+	if len(overrides) > 0 {
+		params.Overrides = &RulesetRuleActionParametersOverrides{Rules: overrides}
+	}
+	rules = append(rules, RulesetRule{
+		Action:           RulesetRuleActionExecute,
+		ActionParameters: params,
+		Expression:       "true",
+		Description:      "Execute Cloudflare Managed Ruleset",
+	})
+	return api.UpdateEntrypointRuleset(zoneID, RulesetPhaseHTTPRequestFirewallManaged, Ruleset{Rules: rules})
+}
+
+// CacheRuleSettings describes the cache behaviour applied by SetCacheRule.
+// EdgeTTL, BrowserTTL and CacheKey are left unchanged when nil.
+type CacheRuleSettings struct {
+	// Cache makes matching responses eligible for caching; when false they
+	// bypass the cache and no TTL may be set.
+	Cache       bool
+	EdgeTTL     *RulesetRuleActionParametersEdgeTTL
+	BrowserTTL  *RulesetRuleActionParametersBrowserTTL
+	CacheKey    *RulesetRuleActionParametersCacheKey
+	Description string
+}
+
+var (
+	// cacheRuleEdgeTTLModes are the modes accepted for the edge TTL of a
+	// cache rule.
+	cacheRuleEdgeTTLModes = []string{"respect_origin", "override_origin", "bypass_by_default"}
+	// cacheRuleBrowserTTLModes are the modes accepted for the browser TTL of
+	// a cache rule.
+	cacheRuleBrowserTTLModes = []string{"respect_origin", "override_origin", "bypass"}
+)
+
+// Rule returns the set_cache_settings rule applying the settings to requests
+// matching expression.
+func (c CacheRuleSettings) Rule(expression string) (RulesetRule, error) {
+	if expression == "" {
+		return RulesetRule{}, errors.New("cache rule expression cannot be empty")
+	}
+	if !c.Cache && (c.EdgeTTL != nil || c.BrowserTTL != nil) {
+		return RulesetRule{}, errors.New("cache rule TTLs require the response to be eligible for cache")
+	}
+	if c.EdgeTTL != nil {
+		if err := validateCacheRuleTTL("edge", c.EdgeTTL.Mode, c.EdgeTTL.Default, cacheRuleEdgeTTLModes); err != nil {
+			return RulesetRule{}, err
+		}
+	}
+	if c.BrowserTTL != nil {
+		if err := validateCacheRuleTTL("browser", c.BrowserTTL.Mode, c.BrowserTTL.Default, cacheRuleBrowserTTLModes); err != nil {
+			return RulesetRule{}, err
+		}
+	}
+	cache := c.Cache
+	return RulesetRule{
+		Action: RulesetRuleActionSetCacheSettings,
+		ActionParameters: &RulesetRuleActionParameters{
+			Cache:      &cache,
+			EdgeTTL:    c.EdgeTTL,
+			BrowserTTL: c.BrowserTTL,
+			CacheKey:   c.CacheKey,
+		},
+		Expression:  expression,
+		Description: c.Description,
+	}, nil
+}
+
+// validateCacheRuleTTL checks the mode of a cache rule TTL against modes and
+// that a TTL is given when the origin is overridden.
+func validateCacheRuleTTL(kind, mode string, ttl int, modes []string) error {
+	if !containsString(modes, mode) {
+		return errors.Errorf("invalid %s TTL mode %q: must be one of %s", kind, mode, strings.Join(modes, ", "))
+	}
+	if mode == "override_origin" && ttl <= 0 {
+		return errors.Errorf("%s TTL overriding the origin requires a positive default", kind)
+	}
+	return nil
+}
+
+// rawRuleset is a Ruleset whose rules are kept as raw JSON, so that they can
+// be sent back exactly as returned, including fields RulesetRule does not
+// model.
+type rawRuleset struct {
+	Ruleset
+	Rules []json.RawMessage `json:"rules"`
+}
+
+// rawRulesetResponse represents a response containing a rawRuleset.
+type rawRulesetResponse struct {
+	Response
+	Result rawRuleset `json:"result"`
+}
+
+// SetCacheRule applies cache to requests of the given zone matching
+// expression, through a rule of the zone's http_request_cache_settings
+// entrypoint. An existing cache rule with the same expression is replaced in
+// place, and enabled if it was disabled; otherwise the rule is appended. The
+// other rules of the entrypoint
+// are sent back byte for byte, so settings this package does not model are
+// kept.
+//
+// An entrypoint which does not exist yet is created. The returned ruleset
+// holds its rules as far as RulesetRule models them.
+//
+// API reference: https://developers.cloudflare.com/cache/how-to/cache-rules/
+func (api *API) SetCacheRule(zoneID, expression string, cache CacheRuleSettings) (Ruleset, error) {
+	rule, err := cache.Rule(expression)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	uri := "/zones/" + zoneID + "/rulesets/phases/" + RulesetPhaseHTTPRequestCacheSettings + "/entrypoint"
+	var current rawRuleset
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		if reqErr, ok := errors.Cause(err).(*RequestError); !ok || reqErr.StatusCode != http.StatusNotFound {
+			return Ruleset{}, errors.Wrap(err, errMakeRequestError)
+		}
+	} else {
+		var r rawRulesetResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+		}
+		current = r.Result
+	}
+
+	replaced := false
+	for i, raw := range current.Rules {
+		// Only the fields identifying the rule are read, so unmodeled ones
+		// are expected and not an error even with strict JSON decoding.
+		var existing RulesetRule
+		if err := json.Unmarshal(raw, &existing); err != nil {
+			return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+		}
+		if existing.Action != RulesetRuleActionSetCacheSettings || existing.Expression != expression {
+			continue
+		}
+		// The new settings are meant to apply, so a disabled rule is
+		// enabled again.
+		enabled := true
+		rule.ID = existing.ID
+		rule.Enabled = &enabled
+		b, err := json.Marshal(rule)
+		if err != nil {
+			return Ruleset{}, errors.Wrap(err, "error marshalling params to JSON")
+		}
+		current.Rules[i] = b
+		replaced = true
+		break
+	}
+	if !replaced {
+		b, err := json.Marshal(rule)
+		if err != nil {
+			return Ruleset{}, errors.Wrap(err, "error marshalling params to JSON")
+		}
+		current.Rules = append(current.Rules, b)
+	}
+
+	res, err = api.makeRequest("PUT", uri, struct {
+		Description string            `json:"description,omitempty"`
+		Rules       []json.RawMessage `json:"rules"`
+	}{current.Description, current.Rules})
+	if err != nil {
+		return Ruleset{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r rawRulesetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	rs := r.Result.Ruleset
+	for _, raw := range r.Result.Rules {
+		var rule RulesetRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+		}
+		rs.Rules = append(rs.Rules, rule)
+	}
+	return rs, nil
+}
+
+// createRuleset creates a ruleset below the given base URI.
+func (api *API) createRuleset(base string, rs Ruleset) (Ruleset, error) {
+	res, err := api.makeRequest("POST", base+"/rulesets", rs)
+	if err != nil {
+		return Ruleset{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RulesetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// listRulesets lists the rulesets below the given base URI.
+func (api *API) listRulesets(base string) ([]Ruleset, error) {
+	res, err := api.makeRequest("GET", base+"/rulesets", nil)
+	if err != nil {
+		return []Ruleset{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ListRulesetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// getRuleset fetches a single ruleset below the given base URI.
+func (api *API) getRuleset(base, rulesetID string) (Ruleset, error) {
+	res, err := api.makeRequest("GET", base+"/rulesets/"+rulesetID, nil)
+	if err != nil {
+		return Ruleset{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RulesetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// updateRuleset updates a single ruleset below the given base URI.
+func (api *API) updateRuleset(base, rulesetID string, rs Ruleset) (Ruleset, error) {
+	body := struct {
+		Description string        `json:"description,omitempty"`
+		Rules       []RulesetRule `json:"rules"`
+	}{rs.Description, rs.Rules}
+	res, err := api.makeRequest("PUT", base+"/rulesets/"+rulesetID, body)
+	if err != nil {
+		return Ruleset{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RulesetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// deleteRuleset deletes a single ruleset below the given base URI.
+func (api *API) deleteRuleset(base, rulesetID string) error {
+	if _, err := api.makeRequest("DELETE", base+"/rulesets/"+rulesetID, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// updateEntrypointRuleset updates the phase entrypoint below the given base
+// URI.
+func (api *API) updateEntrypointRuleset(base, phase string, rs Ruleset) (Ruleset, error) {
+	body := struct {
+		Description string        `json:"description,omitempty"`
+		Rules       []RulesetRule `json:"rules"`
+	}{rs.Description, rs.Rules}
+	res, err := api.makeRequest("PUT", base+"/rulesets/phases/"+phase+"/entrypoint", body)
+	if err != nil {
+		return Ruleset{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r RulesetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
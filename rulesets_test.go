@@ -0,0 +1,891 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRuleset_Transform(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "Transform Rules",
+              "kind": "zone",
+              "phase": "http_request_transform",
+              "rules": [
+                {
+                  "action": "rewrite",
+                  "action_parameters": {
+                    "uri": {
+                      "path": {
+                        "value": "/new-path"
+                      }
+                    },
+                    "headers": {
+                      "X-Source": {
+                        "operation": "set",
+                        "value": "cloudflare"
+                      }
+                    }
+                  },
+                  "expression": "http.request.uri.path eq \"/old-path\"",
+                  "description": "Rewrite old path"
+                }
+              ]
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "2f2feab2026849078ba485f918791bdc",
+            "name": "Transform Rules",
+            "kind": "zone",
+            "version": "1",
+            "last_updated": "2021-05-11T18:43:47.024415Z",
+            "phase": "http_request_transform",
+            "rules": [
+              {
+                "id": "62449e2e0de149619edb35e59c10d801",
+                "version": "1",
+                "action": "rewrite",
+                "action_parameters": {
+                  "uri": {
+                    "path": {
+                      "value": "/new-path"
+                    }
+                  },
+                  "headers": {
+                    "X-Source": {
+                      "operation": "set",
+                      "value": "cloudflare"
+                    }
+                  }
+                },
+                "expression": "http.request.uri.path eq \"/old-path\"",
+                "description": "Rewrite old path",
+                "last_updated": "2021-05-11T18:43:47.024415Z",
+                "ref": "62449e2e0de149619edb35e59c10d801",
+                "enabled": true
+              }
+            ]
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets", handler)
+
+	lastUpdated, _ := time.Parse(time.RFC3339Nano, "2021-05-11T18:43:47.024415Z")
+	enabled := true
+	rewrite := &RulesetRuleActionParameters{
+		URI: &RulesetRuleActionParametersURI{
+			Path: &RulesetRuleActionParametersURIPath{Value: "/new-path"},
+		},
+		Headers: map[string]RulesetRuleActionParametersHTTPHeader{
+			"X-Source": {Operation: "set", Value: "cloudflare"},
+		},
+	}
+	want := Ruleset{
+		ID:          "2f2feab2026849078ba485f918791bdc",
+		Name:        "Transform Rules",
+		Kind:        RulesetKindZone,
+		Version:     "1",
+		LastUpdated: &lastUpdated,
+		Phase:       RulesetPhaseHTTPRequestTransform,
+		Rules: []RulesetRule{{
+			ID:               "62449e2e0de149619edb35e59c10d801",
+			Version:          "1",
+			Action:           RulesetRuleActionRewrite,
+			ActionParameters: rewrite,
+			Expression:       `http.request.uri.path eq "/old-path"`,
+			Description:      "Rewrite old path",
+			LastUpdated:      &lastUpdated,
+			Ref:              "62449e2e0de149619edb35e59c10d801",
+			Enabled:          &enabled,
+		}},
+	}
+
+	actual, err := client.CreateRuleset("023e105f4ecef8ad9ca31a8372d0c353", Ruleset{
+		Name:  "Transform Rules",
+		Kind:  RulesetKindZone,
+		Phase: RulesetPhaseHTTPRequestTransform,
+		Rules: []RulesetRule{{
+			Action:           RulesetRuleActionRewrite,
+			ActionParameters: rewrite,
+			Expression:       `http.request.uri.path eq "/old-path"`,
+			Description:      "Rewrite old path",
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateEntrypointRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "rules": [
+                {
+                  "action": "route",
+                  "action_parameters": {
+                    "origin": {
+                      "host": "origin.example.com",
+                      "port": 8443
+                    },
+                    "host_header": "origin.example.com"
+                  },
+                  "expression": "http.host eq \"app.example.com\""
+                }
+              ]
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "70339d97bdb34195bbf054b1ebe81f76",
+            "name": "default",
+            "kind": "zone",
+            "version": "2",
+            "phase": "http_request_origin",
+            "rules": [
+              {
+                "id": "78723a9e0c7c4c6dbec5684cb766231d",
+                "action": "route",
+                "action_parameters": {
+                  "origin": {
+                    "host": "origin.example.com",
+                    "port": 8443
+                  },
+                  "host_header": "origin.example.com"
+                },
+                "expression": "http.host eq \"app.example.com\""
+              }
+            ]
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_origin/entrypoint", handler)
+
+	route := &RulesetRuleActionParameters{
+		Origin:     &RulesetRuleActionParametersOrigin{Host: "origin.example.com", Port: 8443},
+		HostHeader: "origin.example.com",
+	}
+	actual, err := client.UpdateEntrypointRuleset("023e105f4ecef8ad9ca31a8372d0c353", RulesetPhaseHTTPRequestOrigin, Ruleset{
+		Rules: []RulesetRule{{
+			Action:           RulesetRuleActionRoute,
+			ActionParameters: route,
+			Expression:       `http.host eq "app.example.com"`,
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "70339d97bdb34195bbf054b1ebe81f76", actual.ID)
+		assert.Equal(t, RulesetPhaseHTTPRequestOrigin, actual.Phase)
+		assert.Equal(t, route, actual.Rules[0].ActionParameters)
+	}
+}
+
+func TestDeleteRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/2f2feab2026849078ba485f918791bdc", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteRuleset("023e105f4ecef8ad9ca31a8372d0c353", "2f2feab2026849078ba485f918791bdc")
+	assert.NoError(t, err)
+}
+
+func TestDeployManagedWAFRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "rules": [
+                {
+                  "action": "execute",
+                  "action_parameters": {
+                    "id": "efb7b8c949ac4650a09736fc376e9aee",
+                    "overrides": {
+                      "rules": [
+                        {"id": "5de7edfa648c4d6891dc3e7f84534ffa", "action": "log"},
+                        {"id": "e3a567afc347477d9702d9047e97d760", "enabled": false}
+                      ]
+                    }
+                  },
+                  "expression": "true",
+                  "description": "Execute Cloudflare Managed Ruleset"
+                }
+              ]
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "0f4a4ec6a4ae4b4a9d6a5d3e5f2d1c7b",
+            "name": "default",
+            "kind": "zone",
+            "version": "3",
+            "phase": "http_request_firewall_managed",
+            "rules": [
+              {
+                "id": "3d4c39a0e59a4f0c8e0e2c66b5b0c5b8",
+                "action": "execute",
+                "action_parameters": {
+                  "id": "efb7b8c949ac4650a09736fc376e9aee",
+                  "version": "latest",
+                  "overrides": {
+                    "rules": [
+                      {"id": "5de7edfa648c4d6891dc3e7f84534ffa", "action": "log"},
+                      {"id": "e3a567afc347477d9702d9047e97d760", "enabled": false}
+                    ]
+                  }
+                },
+                "expression": "true",
+                "description": "Execute Cloudflare Managed Ruleset",
+                "enabled": true
+              }
+            ]
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_firewall_managed/entrypoint", handler)
+
+	disabled := false
+	rs, err := client.DeployManagedWAFRuleset("023e105f4ecef8ad9ca31a8372d0c353", []RulesetRuleActionParametersOverridesRule{
+		{ID: "5de7edfa648c4d6891dc3e7f84534ffa", Action: RulesetRuleActionLog},
+		{ID: "e3a567afc347477d9702d9047e97d760", Enabled: &disabled},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, RulesetPhaseHTTPRequestFirewallManaged, rs.Phase)
+		if assert.Equal(t, 1, len(rs.Rules)) {
+			assert.Equal(t, RulesetRuleActionExecute, rs.Rules[0].Action)
+			assert.Equal(t, RulesetIDCloudflareManaged, rs.Rules[0].ActionParameters.ID)
+			assert.Equal(t, 2, len(rs.Rules[0].ActionParameters.Overrides.Rules))
+		}
+	}
+}
+
+func TestUpdateEntrypointRuleset_CacheSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "rules": [
+                {
+                  "action": "set_cache_settings",
+                  "action_parameters": {
+                    "cache": true,
+                    "edge_ttl": {"mode": "override_origin", "default": 86400}
+                  },
+                  "expression": "http.request.uri.path matches \"^/static/\""
+                }
+              ]
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10",
+            "name": "default",
+            "kind": "zone",
+            "version": "1",
+            "phase": "http_request_cache_settings",
+            "rules": [
+              {
+                "id": "3e4c1c2a7d1a4c0fa1b0e8c3b5d6f7a8",
+                "action": "set_cache_settings",
+                "action_parameters": {
+                  "cache": true,
+                  "edge_ttl": {"mode": "override_origin", "default": 86400}
+                },
+                "expression": "http.request.uri.path matches \"^/static/\""
+              }
+            ]
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_cache_settings/entrypoint", handler)
+
+	eligible := true
+	params := &RulesetRuleActionParameters{
+		Cache:   &eligible,
+		EdgeTTL: &RulesetRuleActionParametersEdgeTTL{Mode: "override_origin", Default: 86400},
+	}
+	actual, err := client.UpdateEntrypointRuleset("023e105f4ecef8ad9ca31a8372d0c353", RulesetPhaseHTTPRequestCacheSettings, Ruleset{
+		Rules: []RulesetRule{{
+			Action:           RulesetRuleActionSetCacheSettings,
+			ActionParameters: params,
+			Expression:       `http.request.uri.path matches "^/static/"`,
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, params, actual.Rules[0].ActionParameters)
+	}
+}
+
+func TestManagedWAFException_Rule(t *testing.T) {
+	rule, err := ManagedWAFException{
+		Expression:  `ip.src in {192.0.2.0/24}`,
+		Description: "Trusted scanners",
+		Rules: map[string][]string{
+			RulesetIDCloudflareManaged: {"5de7edfa648c4d6891dc3e7f84534ffa"},
+		},
+	}.Rule()
+	if assert.NoError(t, err) {
+		b, _ := json.Marshal(rule)
+		assert.JSONEq(t, `{
+          "action": "skip",
+          "action_parameters": {
+            "rules": {"efb7b8c949ac4650a09736fc376e9aee": ["5de7edfa648c4d6891dc3e7f84534ffa"]}
+          },
+          "expression": "ip.src in {192.0.2.0/24}",
+          "description": "Trusted scanners"
+        }`, string(b))
+	}
+
+	rule, err = ManagedWAFException{Expression: `cf.client.bot`, Current: true}.Rule()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "current", rule.ActionParameters.Ruleset)
+	}
+
+	_, err = ManagedWAFException{Expression: `cf.client.bot`}.Rule()
+	assert.Error(t, err)
+
+	_, err = ManagedWAFException{Rulesets: []string{RulesetIDCloudflareManaged}}.Rule()
+	assert.Error(t, err)
+}
+
+func TestDeployManagedWAFRulesetWithExceptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "rules": [
+                {
+                  "action": "skip",
+                  "action_parameters": {
+                    "rulesets": ["efb7b8c949ac4650a09736fc376e9aee"],
+                    "phases": ["http_ratelimit"]
+                  },
+                  "expression": "http.request.uri.path eq \"/health\"",
+                  "description": "Health checks"
+                },
+                {
+                  "action": "execute",
+                  "action_parameters": {"id": "efb7b8c949ac4650a09736fc376e9aee"},
+                  "expression": "true",
+                  "description": "Execute Cloudflare Managed Ruleset"
+                }
+              ]
+            }`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "0f4a4ec6a4ae4b4a9d6a5d3e5f2d1c7b",
+            "name": "default",
+            "kind": "zone",
+            "version": "4",
+            "phase": "http_request_firewall_managed",
+            "rules": [
+              {
+                "id": "8b0a9e7a2c6d4d2f9b3c1e5f7a9d0c2e",
+                "action": "skip",
+                "action_parameters": {
+                  "rulesets": ["efb7b8c949ac4650a09736fc376e9aee"],
+                  "phases": ["http_ratelimit"]
+                },
+                "expression": "http.request.uri.path eq \"/health\"",
+                "description": "Health checks"
+              },
+              {
+                "id": "3d4c39a0e59a4f0c8e0e2c66b5b0c5b8",
+                "action": "execute",
+                "action_parameters": {"id": "efb7b8c949ac4650a09736fc376e9aee", "version": "latest"},
+                "expression": "true",
+                "description": "Execute Cloudflare Managed Ruleset"
+              }
+            ]
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_firewall_managed/entrypoint", handler)
+
+	rs, err := client.DeployManagedWAFRulesetWithExceptions("023e105f4ecef8ad9ca31a8372d0c353", []ManagedWAFException{{
+		Expression:  `http.request.uri.path eq "/health"`,
+		Description: "Health checks",
+		Rulesets:    []string{RulesetIDCloudflareManaged},
+		Phases:      []string{RulesetPhaseHTTPRatelimit},
+	}}, nil)
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(rs.Rules)) {
+		assert.Equal(t, RulesetRuleActionSkip, rs.Rules[0].Action)
+		assert.Equal(t, []string{RulesetIDCloudflareManaged}, rs.Rules[0].ActionParameters.Rulesets)
+	}
+}
+
+func TestDeployManagedWAFRulesetWithExceptions_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.DeployManagedWAFRulesetWithExceptions("023e105f4ecef8ad9ca31a8372d0c353", []ManagedWAFException{{Expression: "true"}}, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid exception 0")
+	}
+}
+
+func TestCreateAccountRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "Account WAF",
+              "kind": "custom",
+              "phase": "http_request_firewall_custom",
+              "rules": [
+                {"action": "block", "expression": "ip.geoip.country eq \"T1\"", "description": "Block Tor"}
+              ]
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "4814384a9e5d4991b9815dcfc25d2f1f",
+            "name": "Account WAF",
+            "kind": "custom",
+            "version": "1",
+            "phase": "http_request_firewall_custom",
+            "rules": [
+              {
+                "id": "9a6b3f1c8d2e4f5a8b7c6d5e4f3a2b1c",
+                "version": "1",
+                "action": "block",
+                "expression": "ip.geoip.country eq \"T1\"",
+                "description": "Block Tor"
+              }
+            ]
+          }
+        }`)
+	})
+
+	rs, err := client.CreateAccountRuleset(testAccountID, Ruleset{
+		Name:  "Account WAF",
+		Kind:  RulesetKindCustom,
+		Phase: RulesetPhaseHTTPRequestFirewallCustom,
+		Rules: []RulesetRule{{
+			Action:      RulesetRuleActionBlock,
+			Expression:  `ip.geoip.country eq "T1"`,
+			Description: "Block Tor",
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "4814384a9e5d4991b9815dcfc25d2f1f", rs.ID)
+		assert.Equal(t, 1, len(rs.Rules))
+	}
+}
+
+func TestAccountRulesetURLs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "4814384a9e5d4991b9815dcfc25d2f1f", "kind": "custom"}]}`)
+	})
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/rulesets/4814384a9e5d4991b9815dcfc25d2f1f", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET", "PUT":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "4814384a9e5d4991b9815dcfc25d2f1f", "kind": "custom", "rules": []}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	rulesets, err := client.ListAccountRulesets(testAccountID)
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(rulesets)) {
+		assert.Equal(t, "4814384a9e5d4991b9815dcfc25d2f1f", rulesets[0].ID)
+	}
+	rs, err := client.GetAccountRuleset(testAccountID, "4814384a9e5d4991b9815dcfc25d2f1f")
+	if assert.NoError(t, err) {
+		assert.Equal(t, RulesetKindCustom, rs.Kind)
+	}
+	_, err = client.UpdateAccountRuleset(testAccountID, "4814384a9e5d4991b9815dcfc25d2f1f", Ruleset{})
+	assert.NoError(t, err)
+	assert.NoError(t, client.DeleteAccountRuleset(testAccountID, "4814384a9e5d4991b9815dcfc25d2f1f"))
+}
+
+func TestUpdateAccountEntrypointRuleset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/rulesets/phases/http_request_firewall_custom/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "rules": [
+                {
+                  "action": "execute",
+                  "action_parameters": {"id": "4814384a9e5d4991b9815dcfc25d2f1f"},
+                  "expression": "cf.zone.plan eq \"ENT\"",
+                  "description": "Deploy account WAF"
+                }
+              ]
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "b232b534beea4e00a21dcbb7a8a545e9",
+            "name": "root",
+            "kind": "root",
+            "version": "2",
+            "phase": "http_request_firewall_custom",
+            "rules": [
+              {
+                "id": "2c7f5a1e9b8d4c3fa6e0d1b2c3a4f5e6",
+                "action": "execute",
+                "action_parameters": {"id": "4814384a9e5d4991b9815dcfc25d2f1f", "version": "latest"},
+                "expression": "cf.zone.plan eq \"ENT\"",
+                "description": "Deploy account WAF"
+              }
+            ]
+          }
+        }`)
+	})
+
+	rs, err := client.UpdateAccountEntrypointRuleset(testAccountID, RulesetPhaseHTTPRequestFirewallCustom, Ruleset{
+		Rules: []RulesetRule{{
+			Action:           RulesetRuleActionExecute,
+			ActionParameters: &RulesetRuleActionParameters{ID: "4814384a9e5d4991b9815dcfc25d2f1f"},
+			Expression:       `cf.zone.plan eq "ENT"`,
+			Description:      "Deploy account WAF",
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, RulesetKindRoot, rs.Kind)
+		assert.Equal(t, "latest", rs.Rules[0].ActionParameters.Version)
+	}
+}
+
+func TestCacheRuleSettings_Rule(t *testing.T) {
+	rule, err := CacheRuleSettings{
+		Cache:      true,
+		EdgeTTL:    &RulesetRuleActionParametersEdgeTTL{Mode: "override_origin", Default: 86400},
+		BrowserTTL: &RulesetRuleActionParametersBrowserTTL{Mode: "respect_origin"},
+		CacheKey: &RulesetRuleActionParametersCacheKey{
+			IgnoreQueryStringsOrder: true,
+			CustomKey: &RulesetRuleActionParametersCustomKey{
+				Query: &RulesetRuleActionParametersCustomKeyQuery{Exclude: []string{"utm_source"}},
+			},
+		},
+		Description: "Cache static assets",
+	}.Rule(`http.request.uri.path matches "^/static/"`)
+	if assert.NoError(t, err) {
+		b, err := json.Marshal(rule)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "action": "set_cache_settings",
+              "action_parameters": {
+                "cache": true,
+                "edge_ttl": {"mode": "override_origin", "default": 86400},
+                "browser_ttl": {"mode": "respect_origin"},
+                "cache_key": {
+                  "ignore_query_strings_order": true,
+                  "custom_key": {"query_string": {"exclude": ["utm_source"]}}
+                }
+              },
+              "expression": "http.request.uri.path matches \"^/static/\"",
+              "description": "Cache static assets"
+            }`, string(b))
+		}
+	}
+
+	rule, err = CacheRuleSettings{}.Rule(`http.request.uri.path matches "^/api/"`)
+	if assert.NoError(t, err) {
+		b, err := json.Marshal(rule.ActionParameters)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"cache": false}`, string(b))
+		}
+	}
+}
+
+func TestCacheRuleSettings_RuleInvalid(t *testing.T) {
+	_, err := CacheRuleSettings{Cache: true}.Rule("")
+	assert.EqualError(t, err, "cache rule expression cannot be empty")
+
+	_, err = CacheRuleSettings{EdgeTTL: &RulesetRuleActionParametersEdgeTTL{Mode: "respect_origin"}}.Rule("true")
+	assert.EqualError(t, err, "cache rule TTLs require the response to be eligible for cache")
+
+	_, err = CacheRuleSettings{Cache: true, EdgeTTL: &RulesetRuleActionParametersEdgeTTL{Mode: "forever"}}.Rule("true")
+	assert.EqualError(t, err, `invalid edge TTL mode "forever": must be one of respect_origin, override_origin, bypass_by_default`)
+
+	_, err = CacheRuleSettings{Cache: true, BrowserTTL: &RulesetRuleActionParametersBrowserTTL{Mode: "override_origin"}}.Rule("true")
+	assert.EqualError(t, err, "browser TTL overriding the origin requires a positive default")
+}
+
+func TestSetCacheRule_ReplacesMatchingRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": {
+                "id": "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10",
+                "phase": "http_request_cache_settings",
+                "rules": [
+                  {
+                    "id": "1f1b3c0d9e8a4b7c8d6e5f4a3b2c1d0e",
+                    "action": "set_cache_settings",
+                    "action_parameters": {"cache": false},
+                    "expression": "http.request.uri.path matches \"^/api/\""
+                  },
+                  {
+                    "id": "3e4c1c2a7d1a4c0fa1b0e8c3b5d6f7a8",
+                    "action": "set_cache_settings",
+                    "action_parameters": {"cache": true},
+                    "expression": "http.request.uri.path matches \"^/static/\"",
+                    "enabled": false
+                  }
+                ]
+              }
+            }`)
+		case "PUT":
+			b, err := ioutil.ReadAll(r.Body)
+			defer r.Body.Close()
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{
+                  "rules": [
+                    {
+                      "id": "1f1b3c0d9e8a4b7c8d6e5f4a3b2c1d0e",
+                      "action": "set_cache_settings",
+                      "action_parameters": {"cache": false},
+                      "expression": "http.request.uri.path matches \"^/api/\""
+                    },
+                    {
+                      "id": "3e4c1c2a7d1a4c0fa1b0e8c3b5d6f7a8",
+                      "action": "set_cache_settings",
+                      "action_parameters": {
+                        "cache": true,
+                        "edge_ttl": {"mode": "override_origin", "default": 3600}
+                      },
+                      "expression": "http.request.uri.path matches \"^/static/\"",
+                      "enabled": true
+                    }
+                  ]
+                }`, string(b))
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	_, err := client.SetCacheRule("023e105f4ecef8ad9ca31a8372d0c353", `http.request.uri.path matches "^/static/"`, CacheRuleSettings{
+		Cache:   true,
+		EdgeTTL: &RulesetRuleActionParametersEdgeTTL{Mode: "override_origin", Default: 3600},
+	})
+	assert.NoError(t, err)
+}
+
+func TestSetCacheRule_KeepsUnmodeledFields(t *testing.T) {
+	setup(UsingStrictJSON(true))
+	defer teardown()
+
+	const other = `{
+      "id": "1f1b3c0d9e8a4b7c8d6e5f4a3b2c1d0e",
+      "version": "2",
+      "action": "set_cache_settings",
+      "action_parameters": {
+        "cache": true,
+        "serve_stale": {"disable_stale_while_updating": true},
+        "respect_strong_etags": true,
+        "origin_error_page_passthru": true,
+        "cache_reserve": {"eligible": true, "minimum_file_size": 1024},
+        "cache_key": {"custom_key": {"header": {"include": ["x-tenant"]}, "host": {"resolved": true}}}
+      },
+      "expression": "http.request.uri.path matches \"^/api/\"",
+      "logging": {"enabled": true}
+    }`
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": {"id": "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10", "phase": "http_request_cache_settings", "rules": [%s]}
+            }`, other)
+		case "PUT":
+			b, err := ioutil.ReadAll(r.Body)
+			defer r.Body.Close()
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{
+                  "rules": [
+                    `+other+`,
+                    {
+                      "action": "set_cache_settings",
+                      "action_parameters": {"cache": true},
+                      "expression": "true"
+                    }
+                  ]
+                }`, string(b))
+			}
+			fmt.Fprintf(w, `{
+              "success": true,
+              "errors": [],
+              "messages": [],
+              "result": {"id": "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10", "rules": [%s]}
+            }`, other)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	rs, err := client.SetCacheRule("023e105f4ecef8ad9ca31a8372d0c353", "true", CacheRuleSettings{Cache: true})
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(rs.Rules)) {
+		assert.Equal(t, "1f1b3c0d9e8a4b7c8d6e5f4a3b2c1d0e", rs.Rules[0].ID)
+	}
+}
+
+func TestSetCacheRule_CreatesEntrypoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the http_request_cache_settings phase"}], "messages": [], "result": null}`)
+		case "PUT":
+			b, err := ioutil.ReadAll(r.Body)
+			defer r.Body.Close()
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{
+                  "rules": [
+                    {
+                      "action": "set_cache_settings",
+                      "action_parameters": {"cache": true, "browser_ttl": {"mode": "bypass"}},
+                      "expression": "true"
+                    }
+                  ]
+                }`, string(b))
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	rs, err := client.SetCacheRule("023e105f4ecef8ad9ca31a8372d0c353", "true", CacheRuleSettings{
+		Cache:      true,
+		BrowserTTL: &RulesetRuleActionParametersBrowserTTL{Mode: "bypass"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "b2a8c6f2d9f04c5f8a7c7c1b8e5d3a10", rs.ID)
+	}
+}
+
+func TestSetCacheRule_EntrypointError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/rulesets/phases/http_request_cache_settings/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 10000, "message": "bad request"}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.SetCacheRule("023e105f4ecef8ad9ca31a8372d0c353", "true", CacheRuleSettings{Cache: true})
+	if assert.Error(t, err) {
+		var reqErr *RequestError
+		if assert.True(t, errors.As(err, &reqErr), "expected a *RequestError, got %v", err) {
+			assert.Equal(t, http.StatusBadRequest, reqErr.StatusCode)
+		}
+	}
+}
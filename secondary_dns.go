@@ -0,0 +1,193 @@
+package cloudflare
+
+import (
+	"github.com/pkg/errors"
+)
+
+// SecondaryDNSPrimary describes a primary nameserver that secondary zones
+// transfer their records from.
+type SecondaryDNSPrimary struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	IP         string `json:"ip"`
+	Port       int    `json:"port"`
+	IxfrEnable bool   `json:"ixfr_enable"`
+	TsigID     string `json:"tsig_id,omitempty"`
+}
+
+// SecondaryDNSPrimaryResponse represents the response from the secondary DNS
+// primary endpoints containing a single primary.
+type SecondaryDNSPrimaryResponse struct {
+	Response
+	Result SecondaryDNSPrimary `json:"result"`
+}
+
+// SecondaryDNSPrimariesResponse represents the response from the list
+// secondary DNS primaries endpoint.
+type SecondaryDNSPrimariesResponse struct {
+	Response
+	Result []SecondaryDNSPrimary `json:"result"`
+}
+
+// SecondaryDNSTSIG describes a TSIG key used to authenticate zone transfers.
+//
+// Algo is the algorithm name, e.g. "hmac-sha512.".
+type SecondaryDNSTSIG struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+	Algo   string `json:"algo"`
+}
+
+// SecondaryDNSTSIGResponse represents the response from the TSIG endpoints
+// containing a single TSIG.
+type SecondaryDNSTSIGResponse struct {
+	Response
+	Result SecondaryDNSTSIG `json:"result"`
+}
+
+// SecondaryDNSTSIGsResponse represents the response from the list TSIGs
+// endpoint.
+type SecondaryDNSTSIGsResponse struct {
+	Response
+	Result []SecondaryDNSTSIG `json:"result"`
+}
+
+// CreateSecondaryDNSPrimary creates a primary nameserver for the given
+// account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-create-primary
+func (api *API) CreateSecondaryDNSPrimary(accountID string, primary SecondaryDNSPrimary) (SecondaryDNSPrimary, error) {
+	uri := "/accounts/" + accountID + "/secondary_dns/primaries"
+	res, err := api.makeRequest("POST", uri, primary)
+	if err != nil {
+		return SecondaryDNSPrimary{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSPrimaryResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SecondaryDNSPrimary{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SecondaryDNSPrimaries lists the primary nameservers of the given account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-list-primaries
+func (api *API) SecondaryDNSPrimaries(accountID string) ([]SecondaryDNSPrimary, error) {
+	uri := "/accounts/" + accountID + "/secondary_dns/primaries"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []SecondaryDNSPrimary{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSPrimariesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []SecondaryDNSPrimary{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateSecondaryDNSPrimary updates a single primary nameserver.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-update-primary
+func (api *API) UpdateSecondaryDNSPrimary(accountID string, primary SecondaryDNSPrimary) (SecondaryDNSPrimary, error) {
+	uri := "/accounts/" + accountID + "/secondary_dns/primaries/" + primary.ID
+	res, err := api.makeRequest("PUT", uri, primary)
+	if err != nil {
+		return SecondaryDNSPrimary{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSPrimaryResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SecondaryDNSPrimary{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteSecondaryDNSPrimary deletes a single primary nameserver.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-delete-primary
+func (api *API) DeleteSecondaryDNSPrimary(accountID, primaryID string) error {
+	uri := "/accounts/" + accountID + "/secondary_dns/primaries/" + primaryID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// ForceAXFR asks Cloudflare to immediately transfer the given secondary zone
+// from its primaries instead of waiting for the next refresh.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-force-axfr
+func (api *API) ForceAXFR(zoneID string) error {
+	uri := "/zones/" + zoneID + "/secondary_dns/force_axfr"
+	res, err := api.makeRequest("POST", uri, nil)
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	var r Response
+	if err := api.unmarshal(res, &r); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if !r.Success {
+		return errors.New(errRequestNotSuccessful)
+	}
+	return nil
+}
+
+// CreateSecondaryDNSTSIG creates a TSIG key for the given account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-create-tsig
+func (api *API) CreateSecondaryDNSTSIG(accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs"
+	res, err := api.makeRequest("POST", uri, tsig)
+	if err != nil {
+		return SecondaryDNSTSIG{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSTSIGResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SecondaryDNSTSIG{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SecondaryDNSTSIGs lists the TSIG keys of the given account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-list-tsigs
+func (api *API) SecondaryDNSTSIGs(accountID string) ([]SecondaryDNSTSIG, error) {
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []SecondaryDNSTSIG{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSTSIGsResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []SecondaryDNSTSIG{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateSecondaryDNSTSIG updates a single TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-update-tsig
+func (api *API) UpdateSecondaryDNSTSIG(accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs/" + tsig.ID
+	res, err := api.makeRequest("PUT", uri, tsig)
+	if err != nil {
+		return SecondaryDNSTSIG{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SecondaryDNSTSIGResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SecondaryDNSTSIG{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteSecondaryDNSTSIG deletes a single TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-delete-tsig
+func (api *API) DeleteSecondaryDNSTSIG(accountID, tsigID string) error {
+	uri := "/accounts/" + accountID + "/secondary_dns/tsigs/" + tsigID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
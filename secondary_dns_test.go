@@ -0,0 +1,146 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSecondaryDNSPrimary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/secondary_dns/primaries", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "my-primary-1",
+              "ip": "192.0.2.53",
+              "port": 53,
+              "ixfr_enable": false,
+              "tsig_id": "69cd1e104af3e6ed3cb344f263fd0d5a"
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "23ff594956f20c2a721606e94745a8aa",
+            "name": "my-primary-1",
+            "ip": "192.0.2.53",
+            "port": 53,
+            "ixfr_enable": false,
+            "tsig_id": "69cd1e104af3e6ed3cb344f263fd0d5a"
+          }
+        }`)
+	})
+
+	want := SecondaryDNSPrimary{
+		ID:     "23ff594956f20c2a721606e94745a8aa",
+		Name:   "my-primary-1",
+		IP:     "192.0.2.53",
+		Port:   53,
+		TsigID: "69cd1e104af3e6ed3cb344f263fd0d5a",
+	}
+
+	actual, err := client.CreateSecondaryDNSPrimary(testAccountID, SecondaryDNSPrimary{
+		Name:   "my-primary-1",
+		IP:     "192.0.2.53",
+		Port:   53,
+		TsigID: "69cd1e104af3e6ed3cb344f263fd0d5a",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestSecondaryDNSPrimaries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/secondary_dns/primaries", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "23ff594956f20c2a721606e94745a8aa",
+              "name": "my-primary-1",
+              "ip": "192.0.2.53",
+              "port": 53,
+              "ixfr_enable": true
+            }
+          ]
+        }`)
+	})
+
+	actual, err := client.SecondaryDNSPrimaries(testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []SecondaryDNSPrimary{{
+			ID:         "23ff594956f20c2a721606e94745a8aa",
+			Name:       "my-primary-1",
+			IP:         "192.0.2.53",
+			Port:       53,
+			IxfrEnable: true,
+		}}, actual)
+	}
+}
+
+func TestForceAXFR(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/secondary_dns/force_axfr", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": "OK"
+        }`)
+	})
+
+	err := client.ForceAXFR("023e105f4ecef8ad9ca31a8372d0c353")
+	assert.NoError(t, err)
+}
+
+func TestCreateSecondaryDNSTSIG(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/secondary_dns/tsigs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "69cd1e104af3e6ed3cb344f263fd0d5a",
+            "name": "tsig.customer.cf.",
+            "secret": "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c",
+            "algo": "hmac-sha512."
+          }
+        }`)
+	})
+
+	actual, err := client.CreateSecondaryDNSTSIG(testAccountID, SecondaryDNSTSIG{
+		Name: "tsig.customer.cf.",
+		Algo: "hmac-sha512.",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "69cd1e104af3e6ed3cb344f263fd0d5a", actual.ID)
+		assert.Equal(t, "hmac-sha512.", actual.Algo)
+	}
+}
@@ -0,0 +1,150 @@
+package cloudflare
+
+import (
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Spectrum edge IP types. Dynamic edge IPs are drawn from Cloudflare's
+// anycast ranges, while static edge IPs are fixed addresses, including
+// addresses brought to Cloudflare with BYOIP.
+const (
+	SpectrumEdgeTypeDynamic = "dynamic"
+	SpectrumEdgeTypeStatic  = "static"
+)
+
+// Spectrum edge IP connectivity, the address families of dynamic edge IPs.
+const (
+	SpectrumConnectivityAll  = "all"
+	SpectrumConnectivityIPv4 = "ipv4"
+	SpectrumConnectivityIPv6 = "ipv6"
+)
+
+// SpectrumApplication describes a Spectrum application proxying TCP or UDP
+// traffic to an origin.
+type SpectrumApplication struct {
+	ID            string                      `json:"id,omitempty"`
+	Protocol      string                      `json:"protocol"`
+	DNS           SpectrumApplicationDNS      `json:"dns"`
+	OriginDirect  []string                    `json:"origin_direct,omitempty"`
+	OriginPort    int                         `json:"origin_port,omitempty"`
+	IPFirewall    bool                        `json:"ip_firewall"`
+	ProxyProtocol string                      `json:"proxy_protocol,omitempty"`
+	TLS           string                      `json:"tls,omitempty"`
+	TrafficType   string                      `json:"traffic_type,omitempty"`
+	EdgeIPs       *SpectrumApplicationEdgeIPs `json:"edge_ips,omitempty"`
+	CreatedOn     *time.Time                  `json:"created_on,omitempty"`
+	ModifiedOn    *time.Time                  `json:"modified_on,omitempty"`
+}
+
+// SpectrumApplicationDNS holds the DNS record which points at the edge IPs of
+// an application.
+type SpectrumApplicationDNS struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// SpectrumApplicationEdgeIPs configures the edge IPs an application listens on.
+// Connectivity applies to dynamic edge IPs and IPs to static ones.
+type SpectrumApplicationEdgeIPs struct {
+	Type         string   `json:"type"`
+	Connectivity string   `json:"connectivity,omitempty"`
+	IPs          []net.IP `json:"ips,omitempty"`
+}
+
+// SpectrumApplicationResponse represents the response from the Spectrum
+// endpoints containing a single application.
+type SpectrumApplicationResponse struct {
+	Response
+	Result SpectrumApplication `json:"result"`
+}
+
+// SpectrumApplicationsListResponse represents the response from the list
+// Spectrum applications endpoint.
+type SpectrumApplicationsListResponse struct {
+	Response
+	Result     []SpectrumApplication `json:"result"`
+	ResultInfo ResultInfo            `json:"result_info"`
+}
+
+// SpectrumApplications lists the Spectrum applications of the given zone.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-list-spectrum-applications
+func (api *API) SpectrumApplications(zoneID string) ([]SpectrumApplication, error) {
+	uri := "/zones/" + zoneID + "/spectrum/apps"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SpectrumApplicationsListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []SpectrumApplication{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// SpectrumApplication returns a single Spectrum application.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-get-spectrum-application-configuration
+func (api *API) SpectrumApplication(zoneID, applicationID string) (SpectrumApplication, error) {
+	uri := "/zones/" + zoneID + "/spectrum/apps/" + applicationID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SpectrumApplicationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SpectrumApplication{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateSpectrumApplication creates a new Spectrum application in the given
+// zone.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-create-spectrum-application-using-a-name-for-the-origin
+func (api *API) CreateSpectrumApplication(zoneID string, app SpectrumApplication) (SpectrumApplication, error) {
+	uri := "/zones/" + zoneID + "/spectrum/apps"
+	res, err := api.makeRequest("POST", uri, app)
+	if err != nil {
+		return SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SpectrumApplicationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SpectrumApplication{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateSpectrumApplication replaces the configuration of the Spectrum
+// application identified by app.ID.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-update-spectrum-application-configuration-using-a-name-for-the-origin
+func (api *API) UpdateSpectrumApplication(zoneID string, app SpectrumApplication) (SpectrumApplication, error) {
+	if app.ID == "" {
+		return SpectrumApplication{}, errors.New("application ID cannot be empty")
+	}
+	uri := "/zones/" + zoneID + "/spectrum/apps/" + app.ID
+	res, err := api.makeRequest("PUT", uri, app)
+	if err != nil {
+		return SpectrumApplication{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r SpectrumApplicationResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return SpectrumApplication{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteSpectrumApplication deletes a Spectrum application.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-delete-spectrum-application
+func (api *API) DeleteSpectrumApplication(zoneID, applicationID string) error {
+	uri := "/zones/" + zoneID + "/spectrum/apps/" + applicationID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSpectrumApplication_StaticEdgeIPs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/spectrum/apps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "protocol": "tcp/22",
+              "dns": {"type": "CNAME", "name": "ssh.example.com"},
+              "origin_direct": ["tcp://192.0.2.1:22"],
+              "ip_firewall": true,
+              "edge_ips": {"type": "static", "ips": ["198.51.100.10", "2001:db8::10"]}
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "ea95132c15732412d22c1476fa83f27a",
+            "protocol": "tcp/22",
+            "dns": {"type": "CNAME", "name": "ssh.example.com"},
+            "origin_direct": ["tcp://192.0.2.1:22"],
+            "ip_firewall": true,
+            "proxy_protocol": "off",
+            "tls": "off",
+            "traffic_type": "direct",
+            "edge_ips": {"type": "static", "ips": ["198.51.100.10", "2001:db8::10"]},
+            "created_on": "2014-01-02T02:20:00Z",
+            "modified_on": "2014-01-02T02:20:00Z"
+          }
+        }`)
+	})
+
+	edgeIPs := &SpectrumApplicationEdgeIPs{
+		Type: SpectrumEdgeTypeStatic,
+		IPs:  []net.IP{net.ParseIP("198.51.100.10"), net.ParseIP("2001:db8::10")},
+	}
+	createdOn, _ := time.Parse(time.RFC3339, "2014-01-02T02:20:00Z")
+	want := SpectrumApplication{
+		ID:            "ea95132c15732412d22c1476fa83f27a",
+		Protocol:      "tcp/22",
+		DNS:           SpectrumApplicationDNS{Type: "CNAME", Name: "ssh.example.com"},
+		OriginDirect:  []string{"tcp://192.0.2.1:22"},
+		IPFirewall:    true,
+		ProxyProtocol: "off",
+		TLS:           "off",
+		TrafficType:   "direct",
+		EdgeIPs:       edgeIPs,
+		CreatedOn:     &createdOn,
+		ModifiedOn:    &createdOn,
+	}
+
+	actual, err := client.CreateSpectrumApplication("023e105f4ecef8ad9ca31a8372d0c353", SpectrumApplication{
+		Protocol:     "tcp/22",
+		DNS:          SpectrumApplicationDNS{Type: "CNAME", Name: "ssh.example.com"},
+		OriginDirect: []string{"tcp://192.0.2.1:22"},
+		IPFirewall:   true,
+		EdgeIPs:      edgeIPs,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want.ID, actual.ID)
+		assert.Equal(t, want.EdgeIPs.Type, actual.EdgeIPs.Type)
+		if assert.Equal(t, 2, len(actual.EdgeIPs.IPs)) {
+			assert.True(t, want.EdgeIPs.IPs[0].Equal(actual.EdgeIPs.IPs[0]))
+			assert.True(t, want.EdgeIPs.IPs[1].Equal(actual.EdgeIPs.IPs[1]))
+		}
+		assert.Equal(t, want.CreatedOn, actual.CreatedOn)
+		assert.Equal(t, want.TrafficType, actual.TrafficType)
+	}
+}
+
+func TestSpectrumApplication_DynamicEdgeIPs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/spectrum/apps/ea95132c15732412d22c1476fa83f27a", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "ea95132c15732412d22c1476fa83f27a",
+            "protocol": "tcp/22",
+            "dns": {"type": "CNAME", "name": "ssh.example.com"},
+            "edge_ips": {"type": "dynamic", "connectivity": "ipv4"}
+          }
+        }`)
+	})
+
+	app, err := client.SpectrumApplication("023e105f4ecef8ad9ca31a8372d0c353", "ea95132c15732412d22c1476fa83f27a")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &SpectrumApplicationEdgeIPs{Type: SpectrumEdgeTypeDynamic, Connectivity: SpectrumConnectivityIPv4}, app.EdgeIPs)
+	}
+}
+
+func TestDeleteSpectrumApplication(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/spectrum/apps/ea95132c15732412d22c1476fa83f27a", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "ea95132c15732412d22c1476fa83f27a"}}`)
+	})
+
+	assert.NoError(t, client.DeleteSpectrumApplication("023e105f4ecef8ad9ca31a8372d0c353", "ea95132c15732412d22c1476fa83f27a"))
+}
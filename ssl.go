@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
@@ -60,7 +59,7 @@ func (api *API) CreateSSL(zoneID string, options ZoneCustomSSLOptions) (ZoneCust
 		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneCustomSSLResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -76,7 +75,7 @@ func (api *API) ListSSL(zoneID string) ([]ZoneCustomSSL, error) {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneCustomSSLsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -92,7 +91,7 @@ func (api *API) SSLDetails(zoneID, certificateID string) (ZoneCustomSSL, error)
 		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneCustomSSLResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -108,7 +107,7 @@ func (api *API) UpdateSSL(zoneID, certificateID string, options ZoneCustomSSLOpt
 		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneCustomSSLResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
@@ -130,7 +129,7 @@ func (api *API) ReprioritizeSSL(zoneID string, p []ZoneCustomSSLPriority) ([]Zon
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneCustomSSLsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
+	if err := api.unmarshal(res, &r); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
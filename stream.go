@@ -0,0 +1,167 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// StreamVideo describes a video hosted on Cloudflare Stream.
+type StreamVideo struct {
+	UID               string                 `json:"uid"`
+	Thumbnail         string                 `json:"thumbnail,omitempty"`
+	Preview           string                 `json:"preview,omitempty"`
+	ReadyToStream     bool                   `json:"readyToStream"`
+	Status            StreamVideoStatus      `json:"status"`
+	Meta              map[string]interface{} `json:"meta,omitempty"`
+	Created           *time.Time             `json:"created,omitempty"`
+	Modified          *time.Time             `json:"modified,omitempty"`
+	Uploaded          *time.Time             `json:"uploaded,omitempty"`
+	Size              int                    `json:"size,omitempty"`
+	Duration          float64                `json:"duration,omitempty"`
+	Input             StreamVideoInput       `json:"input"`
+	Playback          StreamVideoPlayback    `json:"playback"`
+	RequireSignedURLs bool                   `json:"requireSignedURLs"`
+	AllowedOrigins    []string               `json:"allowedOrigins,omitempty"`
+}
+
+// StreamVideoStatus describes the processing state of a video. State is one
+// of "pendingupload", "downloading", "queued", "inprogress", "ready" or
+// "error", in which case the error reason fields are set.
+type StreamVideoStatus struct {
+	State           string `json:"state"`
+	PctComplete     string `json:"pctComplete,omitempty"`
+	ErrorReasonCode string `json:"errorReasonCode,omitempty"`
+	ErrorReasonText string `json:"errorReasonText,omitempty"`
+}
+
+// StreamVideoInput describes the dimensions of the uploaded video.
+type StreamVideoInput struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// StreamVideoPlayback holds the manifest URLs a ready video is played from.
+type StreamVideoPlayback struct {
+	HLS  string `json:"hls,omitempty"`
+	DASH string `json:"dash,omitempty"`
+}
+
+// StreamDirectUploadParameters configures a one-time upload URL created by
+// StreamCreateDirectUpload.
+type StreamDirectUploadParameters struct {
+	MaxDurationSeconds int                    `json:"maxDurationSeconds"`
+	Expiry             *time.Time             `json:"expiry,omitempty"`
+	RequireSignedURLs  bool                   `json:"requireSignedURLs,omitempty"`
+	AllowedOrigins     []string               `json:"allowedOrigins,omitempty"`
+	Meta               map[string]interface{} `json:"meta,omitempty"`
+}
+
+// StreamDirectUpload is a one-time URL end users can upload a video to
+// without access to the account's credentials. UID identifies the video once
+// uploaded.
+type StreamDirectUpload struct {
+	UploadURL string `json:"uploadURL"`
+	UID       string `json:"uid"`
+}
+
+// StreamVideoResponse represents the response from the Stream endpoints
+// containing a single video.
+type StreamVideoResponse struct {
+	Response
+	Result StreamVideo `json:"result"`
+}
+
+// StreamListResponse represents the response from the list Stream videos
+// endpoint.
+type StreamListResponse struct {
+	Response
+	Result []StreamVideo `json:"result"`
+}
+
+// StreamDirectUploadResponse represents the response from the Stream direct
+// upload endpoint.
+type StreamDirectUploadResponse struct {
+	Response
+	Result StreamDirectUpload `json:"result"`
+}
+
+// StreamUploadFromURL asks Stream to download the video at videoURL into the
+// given account. The returned video is processed asynchronously; poll
+// StreamVideo until it is ReadyToStream.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-upload-a-video-from-a-url
+func (api *API) StreamUploadFromURL(accountID, videoURL string) (StreamVideo, error) {
+	uri := "/accounts/" + accountID + "/stream/copy"
+	res, err := api.makeRequest("POST", uri, struct {
+		URL string `json:"url"`
+	}{videoURL})
+	if err != nil {
+		return StreamVideo{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r StreamVideoResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return StreamVideo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// StreamCreateDirectUpload creates a one-time upload URL for the given
+// account.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-upload-videos-via-direct-upload-urls
+func (api *API) StreamCreateDirectUpload(accountID string, params StreamDirectUploadParameters) (StreamDirectUpload, error) {
+	uri := "/accounts/" + accountID + "/stream/direct_upload"
+	res, err := api.makeRequest("POST", uri, params)
+	if err != nil {
+		return StreamDirectUpload{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r StreamDirectUploadResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return StreamDirectUpload{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListStreamVideos returns the videos of the given account.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-list-videos
+func (api *API) ListStreamVideos(accountID string) ([]StreamVideo, error) {
+	uri := "/accounts/" + accountID + "/stream"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []StreamVideo{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r StreamListResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []StreamVideo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// StreamVideo returns the given video, including its processing status.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-retrieve-video-details
+func (api *API) StreamVideo(accountID, videoUID string) (StreamVideo, error) {
+	uri := "/accounts/" + accountID + "/stream/" + videoUID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return StreamVideo{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r StreamVideoResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return StreamVideo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteStreamVideo deletes the given video.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-delete-video
+func (api *API) DeleteStreamVideo(accountID, videoUID string) error {
+	uri := "/accounts/" + accountID + "/stream/" + videoUID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamUploadFromURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/copy", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"url": "https://example.com/myvideo.mp4"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "uid": "ea95132c15732412d22c1476fa83f27a",
+            "readyToStream": false,
+            "status": {"state": "downloading"},
+            "input": {"width": -1, "height": -1},
+            "playback": {},
+            "requireSignedURLs": false
+          }
+        }`)
+	})
+
+	video, err := client.StreamUploadFromURL(testAccountID, "https://example.com/myvideo.mp4")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ea95132c15732412d22c1476fa83f27a", video.UID)
+		assert.Equal(t, "downloading", video.Status.State)
+	}
+}
+
+func TestStreamVideo_PlaybackStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/ea95132c15732412d22c1476fa83f27a", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "uid": "ea95132c15732412d22c1476fa83f27a",
+            "thumbnail": "https://videodelivery.net/ea95132c15732412d22c1476fa83f27a/thumbnails/thumbnail.jpg",
+            "readyToStream": true,
+            "status": {"state": "ready", "pctComplete": "100.000000"},
+            "meta": {"name": "My video"},
+            "created": "2014-01-02T02:20:00Z",
+            "modified": "2014-01-02T02:20:00Z",
+            "size": 4190963,
+            "duration": 300.5,
+            "input": {"width": 1920, "height": 1080},
+            "playback": {
+              "hls": "https://videodelivery.net/ea95132c15732412d22c1476fa83f27a/manifest/video.m3u8",
+              "dash": "https://videodelivery.net/ea95132c15732412d22c1476fa83f27a/manifest/video.mpd"
+            },
+            "requireSignedURLs": true
+          }
+        }`)
+	})
+
+	video, err := client.StreamVideo(testAccountID, "ea95132c15732412d22c1476fa83f27a")
+	if assert.NoError(t, err) {
+		assert.True(t, video.ReadyToStream)
+		assert.Equal(t, StreamVideoStatus{State: "ready", PctComplete: "100.000000"}, video.Status)
+		assert.Equal(t, StreamVideoInput{Width: 1920, Height: 1080}, video.Input)
+		assert.Equal(t, "https://videodelivery.net/ea95132c15732412d22c1476fa83f27a/manifest/video.m3u8", video.Playback.HLS)
+		assert.Equal(t, "My video", video.Meta["name"])
+		assert.Equal(t, 300.5, video.Duration)
+	}
+}
@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TurnstileWidget describes a Turnstile widget.
+//
+// Mode is one of "managed", "non-interactive" or "invisible". The Secret is
+// used by the origin to verify tokens and is only returned by the API on
+// create, details and rotation.
+type TurnstileWidget struct {
+	SiteKey      string     `json:"sitekey,omitempty"`
+	Secret       string     `json:"secret,omitempty"`
+	CreatedOn    *time.Time `json:"created_on,omitempty"`
+	ModifiedOn   *time.Time `json:"modified_on,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Domains      []string   `json:"domains,omitempty"`
+	Mode         string     `json:"mode,omitempty"`
+	BotFightMode bool       `json:"bot_fight_mode,omitempty"`
+	Region       string     `json:"region,omitempty"`
+	OffLabel     bool       `json:"offlabel,omitempty"`
+}
+
+// TurnstileWidgetResponse represents the response from the Turnstile widget
+// endpoints containing a single widget.
+type TurnstileWidgetResponse struct {
+	Response
+	Result TurnstileWidget `json:"result"`
+}
+
+// ListTurnstileWidgetResponse represents the response from the list
+// Turnstile widgets endpoint.
+type ListTurnstileWidgetResponse struct {
+	Response
+	Result     []TurnstileWidget `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// CreateTurnstileWidget creates a new Turnstile widget for the given account.
+// The returned widget holds the secret needed to validate tokens.
+//
+// API reference: https://api.cloudflare.com/#turnstile-widgets-create-a-turnstile-widget
+func (api *API) CreateTurnstileWidget(accountID string, widget TurnstileWidget) (TurnstileWidget, error) {
+	uri := "/accounts/" + accountID + "/challenges/widgets"
+	res, err := api.makeRequest("POST", uri, widget)
+	if err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r TurnstileWidgetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListTurnstileWidgets lists all Turnstile widgets of the given account.
+//
+// API reference: https://api.cloudflare.com/#turnstile-widgets-list-turnstile-widgets
+func (api *API) ListTurnstileWidgets(accountID string) ([]TurnstileWidget, error) {
+	var widgets []TurnstileWidget
+	uri := "/accounts/" + accountID + "/challenges/widgets"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 50}, 50, func(res []byte) (ResultInfo, error) {
+		var r ListTurnstileWidgetResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		widgets = append(widgets, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []TurnstileWidget{}, err
+	}
+	return widgets, nil
+}
+
+// TurnstileWidget returns the details of a single Turnstile widget.
+//
+// API reference: https://api.cloudflare.com/#turnstile-widgets-turnstile-widget-details
+func (api *API) TurnstileWidget(accountID, siteKey string) (TurnstileWidget, error) {
+	uri := "/accounts/" + accountID + "/challenges/widgets/" + siteKey
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r TurnstileWidgetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateTurnstileWidget updates the name, domains and mode of the Turnstile
+// widget identified by widget.SiteKey.
+//
+// API reference: https://api.cloudflare.com/#turnstile-widgets-update-a-turnstile-widget
+func (api *API) UpdateTurnstileWidget(accountID string, widget TurnstileWidget) (TurnstileWidget, error) {
+	if widget.SiteKey == "" {
+		return TurnstileWidget{}, errors.New("a sitekey is required to update a turnstile widget")
+	}
+	uri := "/accounts/" + accountID + "/challenges/widgets/" + widget.SiteKey
+	res, err := api.makeRequest("PUT", uri, widget)
+	if err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r TurnstileWidgetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// RotateTurnstileSecret generates a new secret for the given widget. Unless
+// invalidateImmediately is set, the previous secret remains valid for two
+// hours so that origins can be updated.
+//
+// API reference: https://api.cloudflare.com/#turnstile-widgets-rotate-secret-for-a-turnstile-widget
+func (api *API) RotateTurnstileSecret(accountID, siteKey string, invalidateImmediately bool) (TurnstileWidget, error) {
+	uri := "/accounts/" + accountID + "/challenges/widgets/" + siteKey + "/rotate_secret"
+	res, err := api.makeRequest("POST", uri, struct {
+		InvalidateImmediately bool `json:"invalidate_immediately"`
+	}{invalidateImmediately})
+	if err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r TurnstileWidgetResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return TurnstileWidget{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteTurnstileWidget deletes the given Turnstile widget.
+//
+// API reference: https://api.cloudflare.com/#turnstile-widgets-delete-a-turnstile-widget
+func (api *API) DeleteTurnstileWidget(accountID, siteKey string) error {
+	uri := "/accounts/" + accountID + "/challenges/widgets/" + siteKey
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTurnstileWidget(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/challenges/widgets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "blog.example.com login",
+              "domains": ["blog.example.com"],
+              "mode": "managed"
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "sitekey": "0x4AAF00AAAABn0R22HWm-YUc",
+            "secret": "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc",
+            "created_on": "2014-01-01T05:20:00.123123Z",
+            "modified_on": "2014-01-01T05:20:00.123123Z",
+            "name": "blog.example.com login",
+            "domains": ["blog.example.com"],
+            "mode": "managed",
+            "bot_fight_mode": false,
+            "region": "world"
+          }
+        }`)
+	})
+
+	createdOn, _ := time.Parse(time.RFC3339Nano, "2014-01-01T05:20:00.123123Z")
+	want := TurnstileWidget{
+		SiteKey:    "0x4AAF00AAAABn0R22HWm-YUc",
+		Secret:     "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc",
+		CreatedOn:  &createdOn,
+		ModifiedOn: &createdOn,
+		Name:       "blog.example.com login",
+		Domains:    []string{"blog.example.com"},
+		Mode:       "managed",
+		Region:     "world",
+	}
+
+	actual, err := client.CreateTurnstileWidget(testAccountID, TurnstileWidget{
+		Name:    "blog.example.com login",
+		Domains: []string{"blog.example.com"},
+		Mode:    "managed",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestRotateTurnstileSecret(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/challenges/widgets/0x4AAF00AAAABn0R22HWm-YUc/rotate_secret", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"invalidate_immediately": true}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "sitekey": "0x4AAF00AAAABn0R22HWm-YUc",
+            "secret": "0x4AAF00AAAABn0R22HWm0NEWSECRET",
+            "name": "blog.example.com login",
+            "domains": ["blog.example.com"],
+            "mode": "managed"
+          }
+        }`)
+	})
+
+	actual, err := client.RotateTurnstileSecret(testAccountID, "0x4AAF00AAAABn0R22HWm-YUc", true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0x4AAF00AAAABn0R22HWm0NEWSECRET", actual.Secret)
+	}
+}
+
+func TestListTurnstileWidgets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/challenges/widgets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {"sitekey": "0x4AAF00AAAABn0R22HWm-%[1]s", "name": "widget %[1]s", "mode": "invisible"}
+          ],
+          "result_info": {"page": %[1]s, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+        }`, r.URL.Query().Get("page"))
+	})
+
+	actual, err := client.ListTurnstileWidgets(testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []TurnstileWidget{
+			{SiteKey: "0x4AAF00AAAABn0R22HWm-1", Name: "widget 1", Mode: "invisible"},
+			{SiteKey: "0x4AAF00AAAABn0R22HWm-2", Name: "widget 2", Mode: "invisible"},
+		}, actual)
+	}
+}
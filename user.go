@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
@@ -68,7 +67,7 @@ func (api *API) UserDetails() (User, error) {
 		return User{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return User{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -86,7 +85,7 @@ func (api *API) UpdateUser(user *User) (User, error) {
 		return User{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return User{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -104,7 +103,7 @@ func (api *API) UserBillingProfile() (UserBillingProfile, error) {
 		return UserBillingProfile{}, errors.Wrap(err, errMakeRequestError)
 	}
 
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return UserBillingProfile{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -1,7 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"net/url"
 	"strconv"
 
@@ -55,7 +54,7 @@ func (api *API) CreateUserAgentRule(zoneID string, ld UserAgentRule) (*UserAgent
 	}
 
 	response := &UserAgentRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -74,7 +73,7 @@ func (api *API) UpdateUserAgentRule(zoneID string, id string, ld UserAgentRule)
 	}
 
 	response := &UserAgentRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -93,7 +92,7 @@ func (api *API) DeleteUserAgentRule(zoneID string, id string) (*UserAgentRuleRes
 	}
 
 	response := &UserAgentRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -112,7 +111,7 @@ func (api *API) UserAgentRule(zoneID string, id string) (*UserAgentRuleResponse,
 	}
 
 	response := &UserAgentRuleResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -140,7 +139,7 @@ func (api *API) ListUserAgentRules(zoneID string, page int) (*UserAgentRuleListR
 	}
 
 	response := &UserAgentRuleListResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
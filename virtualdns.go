@@ -1,8 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
-
 	"github.com/pkg/errors"
 )
 
@@ -40,7 +38,7 @@ func (api *API) CreateVirtualDNS(v *VirtualDNS) (*VirtualDNS, error) {
 	}
 
 	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -59,7 +57,7 @@ func (api *API) VirtualDNS(virtualDNSID string) (*VirtualDNS, error) {
 	}
 
 	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -77,7 +75,7 @@ func (api *API) ListVirtualDNS() ([]*VirtualDNS, error) {
 	}
 
 	response := &VirtualDNSListResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -96,7 +94,7 @@ func (api *API) UpdateVirtualDNS(virtualDNSID string, vv VirtualDNS) error {
 	}
 
 	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
@@ -116,7 +114,7 @@ func (api *API) DeleteVirtualDNS(virtualDNSID string) error {
 	}
 
 	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return errors.Wrap(err, errUnmarshalError)
 	}
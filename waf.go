@@ -1,8 +1,6 @@
 package cloudflare
 
 import (
-	"encoding/json"
-
 	"github.com/pkg/errors"
 )
 
@@ -57,7 +55,7 @@ func (api *API) ListWAFPackages(zoneID string) ([]WAFPackage, error) {
 	if err != nil {
 		return []WAFPackage{}, errors.Wrap(err, errMakeRequestError)
 	}
-	err = json.Unmarshal(res, &p)
+	err = api.unmarshal(res, &p)
 	if err != nil {
 		return []WAFPackage{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -82,7 +80,7 @@ func (api *API) ListWAFRules(zoneID, packageID string) ([]WAFRule, error) {
 	if err != nil {
 		return []WAFRule{}, errors.Wrap(err, errMakeRequestError)
 	}
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []WAFRule{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -0,0 +1,238 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WaitingRoomRuleActionBypass lets requests matching a waiting room rule skip
+// the queue.
+const WaitingRoomRuleActionBypass = "bypass_waiting_room"
+
+// WaitingRoomEvent describes a scheduled window, such as a product launch,
+// during which a waiting room runs with different settings. The pointer and
+// empty fields are overrides: when unset the waiting room's own setting
+// applies during the event.
+type WaitingRoomEvent struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// EventStartTime and EventEndTime bound the event. Users arriving from
+	// PrequeueStartTime on are queued ahead of the start.
+	EventStartTime      time.Time  `json:"event_start_time"`
+	EventEndTime        time.Time  `json:"event_end_time"`
+	PrequeueStartTime   *time.Time `json:"prequeue_start_time,omitempty"`
+	ShuffleAtEventStart bool       `json:"shuffle_at_event_start,omitempty"`
+	Suspended           bool       `json:"suspended,omitempty"`
+
+	QueueingMethod        string `json:"queueing_method,omitempty"`
+	TotalActiveUsers      int    `json:"total_active_users,omitempty"`
+	NewUsersPerMinute     int    `json:"new_users_per_minute,omitempty"`
+	SessionDuration       int    `json:"session_duration,omitempty"`
+	DisableSessionRenewal *bool  `json:"disable_session_renewal,omitempty"`
+	CustomPageHTML        string `json:"custom_page_html,omitempty"`
+
+	CreatedOn  *time.Time `json:"created_on,omitempty"`
+	ModifiedOn *time.Time `json:"modified_on,omitempty"`
+}
+
+// validate checks that the event has a name and a well-ordered time window.
+func (e WaitingRoomEvent) validate() error {
+	if e.Name == "" {
+		return errors.New("waiting room event name cannot be empty")
+	}
+	if e.EventStartTime.IsZero() || e.EventEndTime.IsZero() {
+		return errors.New("waiting room event requires a start and an end time")
+	}
+	if !e.EventStartTime.Before(e.EventEndTime) {
+		return errors.New("waiting room event must start before it ends")
+	}
+	if e.PrequeueStartTime != nil && !e.PrequeueStartTime.Before(e.EventStartTime) {
+		return errors.New("waiting room event prequeue must start before the event")
+	}
+	return nil
+}
+
+// WaitingRoomRule describes a rule evaluated for requests entering a waiting
+// room, e.g. to let trusted traffic bypass the queue.
+type WaitingRoomRule struct {
+	ID          string     `json:"id,omitempty"`
+	Version     string     `json:"version,omitempty"`
+	Action      string     `json:"action"`
+	Expression  string     `json:"expression"`
+	Description string     `json:"description,omitempty"`
+	Enabled     *bool      `json:"enabled,omitempty"`
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+}
+
+// WaitingRoomEventResponse represents the response from the waiting room
+// event endpoints containing a single event.
+type WaitingRoomEventResponse struct {
+	Response
+	Result WaitingRoomEvent `json:"result"`
+}
+
+// WaitingRoomEventsResponse represents the response from the list waiting
+// room events endpoint.
+type WaitingRoomEventsResponse struct {
+	Response
+	Result     []WaitingRoomEvent `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// WaitingRoomRulesResponse represents the response from the waiting room
+// rules endpoints, which always return every rule of the waiting room.
+type WaitingRoomRulesResponse struct {
+	Response
+	Result []WaitingRoomRule `json:"result"`
+}
+
+// CreateWaitingRoomEvent schedules a new event for the given waiting room.
+// The event is validated before a request is made.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-create-event
+func (api *API) CreateWaitingRoomEvent(zoneID, waitingRoomID string, event WaitingRoomEvent) (WaitingRoomEvent, error) {
+	if err := event.validate(); err != nil {
+		return WaitingRoomEvent{}, err
+	}
+	uri := "/zones/" + zoneID + "/waiting_rooms/" + waitingRoomID + "/events"
+	res, err := api.makeRequest("POST", uri, event)
+	if err != nil {
+		return WaitingRoomEvent{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WaitingRoomEventResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return WaitingRoomEvent{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// WaitingRoomEvents returns all events of the given waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-events
+func (api *API) WaitingRoomEvents(zoneID, waitingRoomID string) ([]WaitingRoomEvent, error) {
+	var events []WaitingRoomEvent
+	uri := "/zones/" + zoneID + "/waiting_rooms/" + waitingRoomID + "/events"
+	err := api.forEachPage(uri, PaginationOptions{PerPage: 100}, 100, func(res []byte) (ResultInfo, error) {
+		var r WaitingRoomEventsResponse
+		if err := api.unmarshal(res, &r); err != nil {
+			return ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+		}
+		events = append(events, r.Result...)
+		return r.ResultInfo, nil
+	})
+	if err != nil {
+		return []WaitingRoomEvent{}, err
+	}
+	return events, nil
+}
+
+// WaitingRoomEvent returns a single event of the given waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-event-details
+func (api *API) WaitingRoomEvent(zoneID, waitingRoomID, eventID string) (WaitingRoomEvent, error) {
+	uri := "/zones/" + zoneID + "/waiting_rooms/" + waitingRoomID + "/events/" + eventID
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return WaitingRoomEvent{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WaitingRoomEventResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return WaitingRoomEvent{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateWaitingRoomEvent replaces an existing event, identified by event.ID.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-update-event
+func (api *API) UpdateWaitingRoomEvent(zoneID, waitingRoomID string, event WaitingRoomEvent) (WaitingRoomEvent, error) {
+	if event.ID == "" {
+		return WaitingRoomEvent{}, errors.New("waiting room event ID cannot be empty")
+	}
+	if err := event.validate(); err != nil {
+		return WaitingRoomEvent{}, err
+	}
+	uri := "/zones/" + zoneID + "/waiting_rooms/" + waitingRoomID + "/events/" + event.ID
+	res, err := api.makeRequest("PUT", uri, event)
+	if err != nil {
+		return WaitingRoomEvent{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WaitingRoomEventResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return WaitingRoomEvent{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteWaitingRoomEvent deletes an event of the given waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-delete-event
+func (api *API) DeleteWaitingRoomEvent(zoneID, waitingRoomID, eventID string) error {
+	uri := "/zones/" + zoneID + "/waiting_rooms/" + waitingRoomID + "/events/" + eventID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
+
+// WaitingRoomRules returns the rules of the given waiting room, in evaluation
+// order.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-waiting-room-rules
+func (api *API) WaitingRoomRules(zoneID, waitingRoomID string) ([]WaitingRoomRule, error) {
+	return api.waitingRoomRulesRequest("GET", "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules", nil)
+}
+
+// CreateWaitingRoomRule appends a rule to the given waiting room and returns
+// all of its rules.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-create-waiting-room-rule
+func (api *API) CreateWaitingRoomRule(zoneID, waitingRoomID string, rule WaitingRoomRule) ([]WaitingRoomRule, error) {
+	return api.waitingRoomRulesRequest("POST", "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules", rule)
+}
+
+// ReplaceWaitingRoomRules replaces all rules of the given waiting room.
+// Passing no rules removes them all.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-replace-waiting-room-rules
+func (api *API) ReplaceWaitingRoomRules(zoneID, waitingRoomID string, rules []WaitingRoomRule) ([]WaitingRoomRule, error) {
+	if rules == nil {
+		rules = []WaitingRoomRule{}
+	}
+	return api.waitingRoomRulesRequest("PUT", "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules", rules)
+}
+
+// UpdateWaitingRoomRule changes a single rule, identified by rule.ID, and
+// returns all rules of the waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-patch-waiting-room-rule
+func (api *API) UpdateWaitingRoomRule(zoneID, waitingRoomID string, rule WaitingRoomRule) ([]WaitingRoomRule, error) {
+	if rule.ID == "" {
+		return nil, errors.New("waiting room rule ID cannot be empty")
+	}
+	return api.waitingRoomRulesRequest("PATCH", "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules/"+rule.ID, rule)
+}
+
+// DeleteWaitingRoomRule deletes a rule of the given waiting room and returns
+// the remaining rules.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-delete-waiting-room-rule
+func (api *API) DeleteWaitingRoomRule(zoneID, waitingRoomID, ruleID string) ([]WaitingRoomRule, error) {
+	return api.waitingRoomRulesRequest("DELETE", "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules/"+ruleID, nil)
+}
+
+// waitingRoomRulesRequest makes a request to one of the waiting room rules
+// endpoints, all of which return the full list of rules.
+func (api *API) waitingRoomRulesRequest(method, uri string, params interface{}) ([]WaitingRoomRule, error) {
+	res, err := api.makeRequest(method, uri, params)
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WaitingRoomRulesResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,183 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testWaitingRoomID = "699d98642c564d2e855e9661899b7252"
+
+func TestCreateWaitingRoomEvent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/waiting_rooms/"+testWaitingRoomID+"/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "product_launch",
+              "event_start_time": "2022-09-01T10:00:00Z",
+              "event_end_time": "2022-09-01T14:00:00Z",
+              "prequeue_start_time": "2022-09-01T09:30:00Z",
+              "shuffle_at_event_start": true,
+              "total_active_users": 500,
+              "new_users_per_minute": 100
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "25756b2dfe6e378a06b033b670413757",
+            "name": "product_launch",
+            "event_start_time": "2022-09-01T10:00:00Z",
+            "event_end_time": "2022-09-01T14:00:00Z",
+            "prequeue_start_time": "2022-09-01T09:30:00Z",
+            "shuffle_at_event_start": true,
+            "total_active_users": 500,
+            "new_users_per_minute": 100,
+            "created_on": "2022-08-20T12:00:00Z",
+            "modified_on": "2022-08-20T12:00:00Z"
+          }
+        }`)
+	})
+
+	start := time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC)
+	prequeue := start.Add(-30 * time.Minute)
+	event, err := client.CreateWaitingRoomEvent("023e105f4ecef8ad9ca31a8372d0c353", testWaitingRoomID, WaitingRoomEvent{
+		Name:                "product_launch",
+		EventStartTime:      start,
+		EventEndTime:        start.Add(4 * time.Hour),
+		PrequeueStartTime:   &prequeue,
+		ShuffleAtEventStart: true,
+		TotalActiveUsers:    500,
+		NewUsersPerMinute:   100,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "25756b2dfe6e378a06b033b670413757", event.ID)
+		assert.Equal(t, start, event.EventStartTime)
+		assert.Equal(t, start.Add(4*time.Hour), event.EventEndTime)
+		assert.Equal(t, &prequeue, event.PrequeueStartTime)
+	}
+}
+
+func TestCreateWaitingRoomEventInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	start := time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		event WaitingRoomEvent
+		err   string
+	}{
+		{WaitingRoomEvent{EventStartTime: start, EventEndTime: start.Add(time.Hour)}, "waiting room event name cannot be empty"},
+		{WaitingRoomEvent{Name: "launch", EventStartTime: start}, "waiting room event requires a start and an end time"},
+		{WaitingRoomEvent{Name: "launch", EventStartTime: start, EventEndTime: start}, "waiting room event must start before it ends"},
+		{WaitingRoomEvent{Name: "launch", EventStartTime: start, EventEndTime: start.Add(time.Hour), PrequeueStartTime: &start}, "waiting room event prequeue must start before the event"},
+	} {
+		_, err := client.CreateWaitingRoomEvent("023e105f4ecef8ad9ca31a8372d0c353", testWaitingRoomID, tc.event)
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
+func TestWaitingRoomEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/waiting_rooms/"+testWaitingRoomID+"/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [{"id": "event_%[1]s", "name": "launch_%[1]s", "event_start_time": "2022-09-01T10:00:00Z", "event_end_time": "2022-09-01T14:00:00Z"}],
+          "result_info": {"page": %[1]s, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+        }`, r.URL.Query().Get("page"))
+	})
+
+	events, err := client.WaitingRoomEvents("023e105f4ecef8ad9ca31a8372d0c353", testWaitingRoomID)
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(events)) {
+		assert.Equal(t, "event_1", events[0].ID)
+		assert.Equal(t, "event_2", events[1].ID)
+	}
+}
+
+func TestCreateWaitingRoomRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/waiting_rooms/"+testWaitingRoomID+"/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"action": "bypass_waiting_room", "expression": "ip.src in {192.0.2.0/24}", "description": "Office"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {"id": "25756b2dfe6e378a06b033b670413757", "version": "1", "action": "bypass_waiting_room", "expression": "ip.src in {192.0.2.0/24}", "description": "Office", "enabled": true}
+          ]
+        }`)
+	})
+
+	rules, err := client.CreateWaitingRoomRule("023e105f4ecef8ad9ca31a8372d0c353", testWaitingRoomID, WaitingRoomRule{
+		Action:      WaitingRoomRuleActionBypass,
+		Expression:  "ip.src in {192.0.2.0/24}",
+		Description: "Office",
+	})
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(rules)) {
+		assert.Equal(t, "25756b2dfe6e378a06b033b670413757", rules[0].ID)
+		assert.True(t, *rules[0].Enabled)
+	}
+}
+
+func TestReplaceWaitingRoomRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/waiting_rooms/"+testWaitingRoomID+"/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[]`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	rules, err := client.ReplaceWaitingRoomRules("023e105f4ecef8ad9ca31a8372d0c353", testWaitingRoomID, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, len(rules))
+	}
+}
+
+func TestDeleteWaitingRoomRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/waiting_rooms/"+testWaitingRoomID+"/rules/25756b2dfe6e378a06b033b670413757", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	rules, err := client.DeleteWaitingRoomRule("023e105f4ecef8ad9ca31a8372d0c353", testWaitingRoomID, "25756b2dfe6e378a06b033b670413757")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, len(rules))
+	}
+}
@@ -0,0 +1,101 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WorkerCronTrigger describes a cron schedule on which a worker script is
+// invoked.
+type WorkerCronTrigger struct {
+	Cron       string     `json:"cron"`
+	CreatedOn  *time.Time `json:"created_on,omitempty"`
+	ModifiedOn *time.Time `json:"modified_on,omitempty"`
+}
+
+// WorkerCronTriggerResponse represents the response from the worker cron
+// trigger endpoints.
+type WorkerCronTriggerResponse struct {
+	Response
+	Result struct {
+		Schedules []WorkerCronTrigger `json:"schedules"`
+	} `json:"result"`
+}
+
+// WorkerTail describes a tail session streaming the logs of a worker script.
+// The session is consumed by connecting a WebSocket to URL.
+type WorkerTail struct {
+	ID        string     `json:"id"`
+	URL       string     `json:"url"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// WorkerTailResponse represents the response from the worker tail endpoint.
+type WorkerTailResponse struct {
+	Response
+	Result WorkerTail `json:"result"`
+}
+
+// WorkerCronTriggers returns the cron triggers of the given worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-cron-trigger-get-cron-triggers
+func (api *API) WorkerCronTriggers(accountID, scriptName string) ([]WorkerCronTrigger, error) {
+	uri := "/accounts/" + accountID + "/workers/scripts/" + scriptName + "/schedules"
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return []WorkerCronTrigger{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WorkerCronTriggerResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []WorkerCronTrigger{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Schedules, nil
+}
+
+// UpdateWorkerCronTriggers replaces the cron triggers of the given worker
+// script. Passing no triggers removes all schedules.
+//
+// API reference: https://api.cloudflare.com/#worker-cron-trigger-update-cron-triggers
+func (api *API) UpdateWorkerCronTriggers(accountID, scriptName string, crons []WorkerCronTrigger) ([]WorkerCronTrigger, error) {
+	if crons == nil {
+		crons = []WorkerCronTrigger{}
+	}
+	uri := "/accounts/" + accountID + "/workers/scripts/" + scriptName + "/schedules"
+	res, err := api.makeRequest("PUT", uri, crons)
+	if err != nil {
+		return []WorkerCronTrigger{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WorkerCronTriggerResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return []WorkerCronTrigger{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Schedules, nil
+}
+
+// StartWorkerTail starts a tail session for the given worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-tail-logs-start-tail
+func (api *API) StartWorkerTail(accountID, scriptName string) (WorkerTail, error) {
+	uri := "/accounts/" + accountID + "/workers/scripts/" + scriptName + "/tails"
+	res, err := api.makeRequest("POST", uri, nil)
+	if err != nil {
+		return WorkerTail{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r WorkerTailResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return WorkerTail{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteWorkerTail ends the given tail session.
+//
+// API reference: https://api.cloudflare.com/#worker-tail-logs-delete-tail
+func (api *API) DeleteWorkerTail(accountID, scriptName, tailID string) error {
+	uri := "/accounts/" + accountID + "/workers/scripts/" + scriptName + "/tails/" + tailID
+	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	return nil
+}
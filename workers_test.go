@@ -0,0 +1,73 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateWorkerCronTriggers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/example-script/schedules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[{"cron": "*/30 * * * *"}, {"cron": "0 4 * * MON"}]`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "schedules": [
+              {"cron": "*/30 * * * *", "created_on": "2017-01-01T00:00:00Z", "modified_on": "2017-01-01T00:00:00Z"},
+              {"cron": "0 4 * * MON", "created_on": "2017-01-01T00:00:00Z", "modified_on": "2017-01-01T00:00:00Z"}
+            ]
+          }
+        }`)
+	})
+
+	crons, err := client.UpdateWorkerCronTriggers(testAccountID, "example-script", []WorkerCronTrigger{
+		{Cron: "*/30 * * * *"},
+		{Cron: "0 4 * * MON"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(crons))
+		assert.Equal(t, "0 4 * * MON", crons[1].Cron)
+		assert.NotNil(t, crons[1].CreatedOn)
+	}
+}
+
+func TestStartWorkerTail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/example-script/tails", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "03dc9f77817b488fb26c5861ec18f791",
+            "url": "wss://tail.developers.workers.dev/03dc9f77817b488fb26c5861ec18f791",
+            "expires_at": "2021-08-20T19:15:51Z"
+          }
+        }`)
+	})
+
+	tail, err := client.StartWorkerTail(testAccountID, "example-script")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "03dc9f77817b488fb26c5861ec18f791", tail.ID)
+		assert.Equal(t, "wss://tail.developers.workers.dev/03dc9f77817b488fb26c5861ec18f791", tail.URL)
+		assert.NotNil(t, tail.ExpiresAt)
+	}
+}
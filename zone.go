@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,6 +17,12 @@ type Owner struct {
 	OwnerType string `json:"owner_type"`
 }
 
+// ZoneAccount identifies the account a zone belongs to.
+type ZoneAccount struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 // Zone describes a Cloudflare zone.
 type Zone struct {
 	ID   string `json:"id"`
@@ -30,6 +37,7 @@ type Zone struct {
 	ModifiedOn        time.Time    `json:"modified_on"`
 	NameServers       []string     `json:"name_servers"`
 	Owner             Owner        `json:"owner"`
+	Account           ZoneAccount  `json:"account"`
 	Permissions       []string     `json:"permissions"`
 	Plan              ZoneRatePlan `json:"plan"`
 	PlanPending       ZoneRatePlan `json:"plan_pending,omitempty"`
@@ -44,6 +52,9 @@ type Zone struct {
 	Betas       []string `json:"betas"`
 	DeactReason string   `json:"deactivation_reason"`
 	Meta        ZoneMeta `json:"meta"`
+	// VerificationKey is the value of the TXT record used to verify
+	// ownership of partial (CNAME setup) zones.
+	VerificationKey string `json:"verification_key,omitempty"`
 }
 
 // ZoneMeta describes metadata about a zone.
@@ -59,11 +70,16 @@ type ZoneMeta struct {
 type ZoneRatePlan struct {
 	ID         string                   `json:"id"`
 	Name       string                   `json:"name,omitempty"`
+	LegacyID   string                   `json:"legacy_id,omitempty"`
 	Price      int                      `json:"price,omitempty"`
 	Currency   string                   `json:"currency,omitempty"`
 	Duration   int                      `json:"duration,omitempty"`
 	Frequency  string                   `json:"frequency,omitempty"`
 	Components []zoneRatePlanComponents `json:"components,omitempty"`
+	// IsSubscribed and CanSubscribe are read-only and report whether the
+	// zone is subscribed to the plan and whether it may subscribe to it.
+	IsSubscribed bool `json:"is_subscribed,omitempty"`
+	CanSubscribe bool `json:"can_subscribe,omitempty"`
 }
 
 type zoneRatePlanComponents struct {
@@ -123,6 +139,13 @@ type ZoneSettingResponse struct {
 	Result []ZoneSetting `json:"result"`
 }
 
+// ZoneSettingSingleResponse represents the response from the endpoints of an
+// individual Zone Setting.
+type ZoneSettingSingleResponse struct {
+	Response
+	Result ZoneSetting `json:"result"`
+}
+
 // ZoneSSLSetting contains ssl setting for a zone.
 type ZoneSSLSetting struct {
 	ID                string `json:"id"`
@@ -209,6 +232,36 @@ type ZoneAnalyticsOptions struct {
 	Since      *time.Time
 	Until      *time.Time
 	Continuous *bool
+	// EnforceRetention makes the request fail before it is sent if Since is
+	// older than the plan of the zone retains, at the cost of looking up the
+	// plan. It is not sent to the API.
+	EnforceRetention bool
+}
+
+// ZoneAnalyticsLimits describes how far back the analytics of a zone are
+// retained and the granularities the timeseries can be reported in, both of
+// which depend on the plan of the zone.
+type ZoneAnalyticsLimits struct {
+	Plan          string
+	Retention     time.Duration
+	Granularities []string
+}
+
+// zoneAnalyticsLimits holds the limits of each plan, keyed by legacy plan ID.
+var zoneAnalyticsLimits = map[string]ZoneAnalyticsLimits{
+	"free":       {Plan: "free", Retention: 30 * 24 * time.Hour, Granularities: []string{"hour", "day"}},
+	"pro":        {Plan: "pro", Retention: 30 * 24 * time.Hour, Granularities: []string{"15min", "hour", "day"}},
+	"business":   {Plan: "business", Retention: 30 * 24 * time.Hour, Granularities: []string{"1min", "15min", "hour", "day"}},
+	"enterprise": {Plan: "enterprise", Retention: 365 * 24 * time.Hour, Granularities: []string{"1min", "15min", "hour", "day"}},
+}
+
+// ZoneAnalyticsPlanLimits returns the analytics limits of plan. Plans which
+// are not known are given the limits of the free plan.
+func ZoneAnalyticsPlanLimits(plan ZoneRatePlan) ZoneAnalyticsLimits {
+	if l, ok := zoneAnalyticsLimits[plan.LegacyID]; ok {
+		return l
+	}
+	return zoneAnalyticsLimits["free"]
 }
 
 // PurgeCacheRequest represents the request format made to the purge endpoint.
@@ -231,10 +284,18 @@ type PurgeCacheResponse struct {
 	} `json:"result"`
 }
 
+// Zone types.
+const (
+	ZoneTypeFull      = "full"
+	ZoneTypePartial   = "partial"
+	ZoneTypeSecondary = "secondary"
+)
+
 // newZone describes a new zone.
 type newZone struct {
 	Name      string `json:"name"`
 	JumpStart bool   `json:"jump_start"`
+	Type      string `json:"type,omitempty"`
 	// We use a pointer to get a nil type when the field is empty.
 	// This allows us to completely omit this with json.Marshal().
 	Organization *Organization `json:"organization,omitempty"`
@@ -248,11 +309,22 @@ type newZone struct {
 // If Organization is non-empty, it must have at least the ID field populated.
 // This will add the new zone to the specified multi-user organization.
 //
+// zoneType is one of ZoneTypeFull, ZoneTypePartial or ZoneTypeSecondary, and
+// defaults to a full zone if empty. Partial zones are set up via CNAME and are
+// returned with the VerificationKey used to prove ownership.
+//
 // API reference: https://api.cloudflare.com/#zone-create-a-zone
-func (api *API) CreateZone(name string, jumpstart bool, org Organization) (Zone, error) {
+func (api *API) CreateZone(name string, jumpstart bool, org Organization, zoneType string) (Zone, error) {
+	switch zoneType {
+	case "", ZoneTypeFull, ZoneTypePartial, ZoneTypeSecondary:
+	default:
+		return Zone{}, errors.Errorf("invalid zone type %q: must be one of full, partial, secondary", zoneType)
+	}
+
 	var newzone newZone
 	newzone.Name = name
 	newzone.JumpStart = jumpstart
+	newzone.Type = zoneType
 	if org.ID != "" {
 		newzone.Organization = &org
 	}
@@ -263,7 +335,7 @@ func (api *API) CreateZone(name string, jumpstart bool, org Organization) (Zone,
 	}
 
 	var r ZoneResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return Zone{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -279,7 +351,7 @@ func (api *API) ZoneActivationCheck(zoneID string) (Response, error) {
 		return Response{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r Response
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return Response{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -303,7 +375,7 @@ func (api *API) ListZones(z ...string) ([]Zone, error) {
 			if err != nil {
 				return []Zone{}, errors.Wrap(err, errMakeRequestError)
 			}
-			err = json.Unmarshal(res, &r)
+			err = api.unmarshal(res, &r)
 			if err != nil {
 				return []Zone{}, errors.Wrap(err, errUnmarshalError)
 			}
@@ -323,7 +395,7 @@ func (api *API) ListZones(z ...string) ([]Zone, error) {
 		if err != nil {
 			return []Zone{}, errors.Wrap(err, errMakeRequestError)
 		}
-		err = json.Unmarshal(res, &r)
+		err = api.unmarshal(res, &r)
 		if err != nil {
 			return []Zone{}, errors.Wrap(err, errUnmarshalError)
 		}
@@ -342,7 +414,7 @@ func (api *API) ZoneDetails(zoneID string) (Zone, error) {
 		return Zone{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r ZoneResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return Zone{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -368,6 +440,17 @@ func (api *API) ZoneSetPaused(zoneID string, paused bool) (Zone, error) {
 	return zone, nil
 }
 
+// PauseZone pauses Cloudflare service for the zone. Only the paused flag is
+// sent; other zone properties are left unchanged.
+func (api *API) PauseZone(zoneID string) (Zone, error) {
+	return api.ZoneSetPaused(zoneID, true)
+}
+
+// UnpauseZone resumes Cloudflare service for a previously paused zone.
+func (api *API) UnpauseZone(zoneID string) (Zone, error) {
+	return api.ZoneSetPaused(zoneID, false)
+}
+
 // ZoneSetVanityNS sets custom nameservers for the zone.
 // These names must be within the same zone.
 func (api *API) ZoneSetVanityNS(zoneID string, ns []string) (Zone, error) {
@@ -402,7 +485,7 @@ func (api *API) EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error) {
 		return Zone{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r ZoneResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return Zone{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -410,20 +493,29 @@ func (api *API) EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error) {
 	return r.Result, nil
 }
 
-// PurgeEverything purges the cache for the given zone.
+// ErrPurgeEverythingNotConfirmed is returned by PurgeEverything when it is
+// called without confirmation.
+var ErrPurgeEverythingNotConfirmed = errors.New("purging everything requires confirmation")
+
+// PurgeEverything purges the cache for the given zone. As a safeguard against
+// accidental calls, nothing is purged and ErrPurgeEverythingNotConfirmed is
+// returned unless confirm is true.
 //
 // Note: this will substantially increase load on the origin server for that
 // zone if there is a high cached vs. uncached request ratio.
 //
 // API reference: https://api.cloudflare.com/#zone-purge-all-files
-func (api *API) PurgeEverything(zoneID string) (PurgeCacheResponse, error) {
+func (api *API) PurgeEverything(zoneID string, confirm bool) (PurgeCacheResponse, error) {
+	if !confirm {
+		return PurgeCacheResponse{}, ErrPurgeEverythingNotConfirmed
+	}
 	uri := "/zones/" + zoneID + "/purge_cache"
 	res, err := api.makeRequest("DELETE", uri, PurgeCacheRequest{true, nil, nil, nil})
 	if err != nil {
 		return PurgeCacheResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r PurgeCacheResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return PurgeCacheResponse{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -440,7 +532,7 @@ func (api *API) PurgeCache(zoneID string, pcr PurgeCacheRequest) (PurgeCacheResp
 		return PurgeCacheResponse{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r PurgeCacheResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return PurgeCacheResponse{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -456,7 +548,7 @@ func (api *API) DeleteZone(zoneID string) (ZoneID, error) {
 		return ZoneID{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r ZoneIDResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return ZoneID{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -467,19 +559,76 @@ func (api *API) DeleteZone(zoneID string) (ZoneID, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-plan-available-plans
 func (api *API) AvailableZoneRatePlans(zoneID string) ([]ZoneRatePlan, error) {
-	uri := "/zones/" + zoneID + "/available_rate_plans"
+	return api.zoneRatePlans("/zones/" + zoneID + "/available_rate_plans")
+}
+
+// ZoneAvailablePlans returns the plans the specified zone can subscribe to,
+// including its current plan, which has IsSubscribed set. Unlike
+// AvailableZoneRatePlans it uses the documented available_plans endpoint.
+//
+// API reference: https://api.cloudflare.com/#zone-plan-available-plans
+func (api *API) ZoneAvailablePlans(zoneID string) ([]ZoneRatePlan, error) {
+	return api.zoneRatePlans("/zones/" + zoneID + "/available_plans")
+}
+
+// zoneRatePlans fetches the list of plans at uri.
+func (api *API) zoneRatePlans(uri string) ([]ZoneRatePlan, error) {
 	res, err := api.makeRequest("GET", uri, nil)
 	if err != nil {
 		return []ZoneRatePlan{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r AvailableZoneRatePlansResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return []ZoneRatePlan{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
 }
 
+// validate reports a Since which is not before Until.
+func (o ZoneAnalyticsOptions) validate() error {
+	if o.Since != nil && o.Until != nil && !o.Since.Before(*o.Until) {
+		return errors.Errorf("invalid analytics range: since %s is not before until %s",
+			o.Since.Format(time.RFC3339), o.Until.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// CheckLimits reports options asking for analytics older than limits retain
+// as of now, so they can be rejected with a clear error instead of the one
+// returned by the API.
+func (o ZoneAnalyticsOptions) CheckLimits(limits ZoneAnalyticsLimits, now time.Time) error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+	oldest := now.Add(-limits.Retention)
+	if o.Since != nil && o.Since.Before(oldest) {
+		return errors.Errorf("invalid analytics range: since %s is older than the %d days retained for the %s plan (oldest allowed is %s)",
+			o.Since.Format(time.RFC3339), int(limits.Retention.Hours()/24), limits.Plan, oldest.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Clamp returns a copy of the options with Since moved forward to the oldest
+// time retained by limits as of now, if it is older.
+func (o ZoneAnalyticsOptions) Clamp(limits ZoneAnalyticsLimits, now time.Time) ZoneAnalyticsOptions {
+	oldest := now.Add(-limits.Retention)
+	if o.Since != nil && o.Since.Before(oldest) {
+		o.Since = &oldest
+	}
+	return o
+}
+
+// ZoneAnalyticsLimits returns the analytics limits of the plan of the given
+// zone.
+func (api *API) ZoneAnalyticsLimits(zoneID string) (ZoneAnalyticsLimits, error) {
+	z, err := api.ZoneDetails(zoneID)
+	if err != nil {
+		return ZoneAnalyticsLimits{}, err
+	}
+	return ZoneAnalyticsPlanLimits(z.Plan), nil
+}
+
 // encode encodes non-nil fields into URL encoded form.
 func (o ZoneAnalyticsOptions) encode() string {
 	v := url.Values{}
@@ -495,17 +644,40 @@ func (o ZoneAnalyticsOptions) encode() string {
 	return v.Encode()
 }
 
+// checkRetention returns the error of CheckLimits for the plan of the given
+// zone if options ask for EnforceRetention.
+func (api *API) checkRetention(zoneID string, options ZoneAnalyticsOptions) error {
+	if !options.EnforceRetention {
+		return nil
+	}
+	limits, err := api.ZoneAnalyticsLimits(zoneID)
+	if err != nil {
+		return err
+	}
+	return options.CheckLimits(limits, time.Now())
+}
+
 // ZoneAnalyticsDashboard returns zone analytics information.
 //
+// The API rejects a Since older than the plan of the zone retains. Set
+// EnforceRetention to get a clear error naming the plan and the oldest time
+// allowed instead, or use Clamp on the options to move Since forward.
+//
 // API reference: https://api.cloudflare.com/#zone-analytics-dashboard
 func (api *API) ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptions) (ZoneAnalyticsData, error) {
+	if err := options.validate(); err != nil {
+		return ZoneAnalyticsData{}, err
+	}
+	if err := api.checkRetention(zoneID, options); err != nil {
+		return ZoneAnalyticsData{}, err
+	}
 	uri := "/zones/" + zoneID + "/analytics/dashboard" + "?" + options.encode()
 	res, err := api.makeRequest("GET", uri, nil)
 	if err != nil {
 		return ZoneAnalyticsData{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneAnalyticsDataResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return ZoneAnalyticsData{}, errors.Wrap(err, errUnmarshalError)
 	}
@@ -513,16 +685,23 @@ func (api *API) ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptio
 }
 
 // ZoneAnalyticsByColocation returns zone analytics information by datacenter.
+// Since is subject to plan retention as for ZoneAnalyticsDashboard.
 //
 // API reference: https://api.cloudflare.com/#zone-analytics-analytics-by-co-locations
 func (api *API) ZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOptions) ([]ZoneAnalyticsColocation, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	if err := api.checkRetention(zoneID, options); err != nil {
+		return nil, err
+	}
 	uri := "/zones/" + zoneID + "/analytics/colos" + "?" + options.encode()
 	res, err := api.makeRequest("GET", uri, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errMakeRequestError)
 	}
 	var r zoneAnalyticsColocationResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -540,7 +719,7 @@ func (api *API) ZoneSettings(zoneID string) (*ZoneSettingResponse, error) {
 	}
 
 	response := &ZoneSettingResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -548,6 +727,65 @@ func (api *API) ZoneSettings(zoneID string) (*ZoneSettingResponse, error) {
 	return response, nil
 }
 
+// ZoneSettingMap holds the settings of a zone keyed by setting ID, e.g.
+// "always_use_https", keeping the Editable flag of each setting.
+type ZoneSettingMap map[string]ZoneSetting
+
+// ZoneSettingsMap returns all of the settings for a given zone keyed by
+// setting ID, for lookups of individual settings.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-all-zone-settings
+func (api *API) ZoneSettingsMap(zoneID string) (ZoneSettingMap, error) {
+	r, err := api.ZoneSettings(zoneID)
+	if err != nil {
+		return nil, err
+	}
+	m := make(ZoneSettingMap, len(r.Result))
+	for _, s := range r.Result {
+		m[s.ID] = s
+	}
+	return m, nil
+}
+
+// Value returns the value of the setting with the given ID, and whether the
+// setting is present.
+func (m ZoneSettingMap) Value(id string) (interface{}, bool) {
+	s, ok := m[id]
+	return s.Value, ok
+}
+
+// String returns the value of a setting holding a string, such as
+// "security_level". ok is false if the setting is missing or not a string.
+func (m ZoneSettingMap) String(id string) (value string, ok bool) {
+	value, ok = m[id].Value.(string)
+	return value, ok
+}
+
+// Bool returns whether an on/off setting, such as "always_use_https", is on.
+// ok is false if the setting is missing or neither "on" nor "off".
+func (m ZoneSettingMap) Bool(id string) (on bool, ok bool) {
+	switch m[id].Value {
+	case "on":
+		return true, true
+	case "off":
+		return false, true
+	}
+	return false, false
+}
+
+// Int returns the value of a numeric setting, such as "browser_cache_ttl".
+// ok is false if the setting is missing or not a number.
+func (m ZoneSettingMap) Int(id string) (value int, ok bool) {
+	f, ok := m[id].Value.(float64)
+	return int(f), ok
+}
+
+// Editable reports whether the setting with the given ID can be changed. It
+// is false for read-only and missing settings.
+func (m ZoneSettingMap) Editable(id string) bool {
+	return m[id].Editable
+}
+
 // UpdateZoneSettings updates the settings for a given zone.
 //
 // API reference: https://api.cloudflare.com/#zone-settings-edit-zone-settings-info
@@ -561,7 +799,7 @@ func (api *API) UpdateZoneSettings(zoneID string, settings []ZoneSetting) (*Zone
 	}
 
 	response := &ZoneSettingResponse{}
-	err = json.Unmarshal(res, &response)
+	err = api.unmarshal(res, &response)
 	if err != nil {
 		return nil, errors.Wrap(err, errUnmarshalError)
 	}
@@ -579,9 +817,469 @@ func (api *API) ZoneSSLSettings(zoneID string) (ZoneSSLSetting, error) {
 		return ZoneSSLSetting{}, errors.Wrap(err, errMakeRequestError)
 	}
 	var r ZoneSSLSettingResponse
-	err = json.Unmarshal(res, &r)
+	err = api.unmarshal(res, &r)
 	if err != nil {
 		return ZoneSSLSetting{}, errors.Wrap(err, errUnmarshalError)
 	}
 	return r.Result, nil
 }
+
+// Zone security levels.
+const (
+	SecurityLevelOff            = "off"
+	SecurityLevelEssentiallyOff = "essentially_off"
+	SecurityLevelLow            = "low"
+	SecurityLevelMedium         = "medium"
+	SecurityLevelHigh           = "high"
+	SecurityLevelUnderAttack    = "under_attack"
+)
+
+var securityLevels = []string{
+	SecurityLevelOff,
+	SecurityLevelEssentiallyOff,
+	SecurityLevelLow,
+	SecurityLevelMedium,
+	SecurityLevelHigh,
+	SecurityLevelUnderAttack,
+}
+
+// challengeTTLs are the challenge TTLs, in seconds, accepted by the API.
+var challengeTTLs = []int{300, 900, 1800, 2700, 3600, 7200, 10800, 14400, 28800, 57600, 86400, 604800, 2592000, 31536000}
+
+// ZoneSecurityLevel returns the security level of the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-security-level-setting
+func (api *API) ZoneSecurityLevel(zoneID string) (string, error) {
+	s, err := api.zoneSetting(zoneID, "security_level")
+	if err != nil {
+		return "", err
+	}
+	level, _ := s.Value.(string)
+	return level, nil
+}
+
+// SetZoneSecurityLevel changes the security level of the given zone and
+// returns the updated level. The level must be one of off, essentially_off,
+// low, medium, high or under_attack.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-security-level-setting
+func (api *API) SetZoneSecurityLevel(zoneID, level string) (string, error) {
+	if !containsString(securityLevels, level) {
+		return "", errors.Errorf("invalid security level %q: must be one of %s", level, strings.Join(securityLevels, ", "))
+	}
+
+	s, err := api.updateZoneSetting(zoneID, "security_level", level)
+	if err != nil {
+		return "", err
+	}
+	updated, _ := s.Value.(string)
+	return updated, nil
+}
+
+// ZoneChallengeTTL returns how long, in seconds, a visitor who passed a
+// challenge is allowed access to the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-challenge-ttl-setting
+func (api *API) ZoneChallengeTTL(zoneID string) (int, error) {
+	s, err := api.zoneSetting(zoneID, "challenge_ttl")
+	if err != nil {
+		return 0, err
+	}
+	ttl, _ := s.Value.(float64)
+	return int(ttl), nil
+}
+
+// SetZoneChallengeTTL changes the challenge TTL of the given zone and returns
+// the updated value. Only the TTLs offered by the API are accepted, ranging
+// from 300 seconds to a year.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-challenge-ttl-setting
+func (api *API) SetZoneChallengeTTL(zoneID string, ttl int) (int, error) {
+	if !containsInt(challengeTTLs, ttl) {
+		return 0, errors.Errorf("invalid challenge TTL %d: must be one of %v", ttl, challengeTTLs)
+	}
+
+	s, err := api.updateZoneSetting(zoneID, "challenge_ttl", ttl)
+	if err != nil {
+		return 0, err
+	}
+	updated, _ := s.Value.(float64)
+	return int(updated), nil
+}
+
+// browserCacheTTLs are the browser cache TTLs, in seconds, accepted by the
+// API. Zero respects the cache headers sent by the origin.
+var browserCacheTTLs = []int{0, 30, 60, 120, 300, 1200, 1800, 3600, 7200, 10800, 14400, 18000, 28800, 43200, 57600, 72000, 86400, 172800, 259200, 345600, 432000, 691200, 1382400, 2073600, 2678400, 5356800, 16070400, 31536000}
+
+// SetBrowserCacheTTL changes how long, in seconds, browsers are told to cache
+// the resources of the given zone and returns the updated value. Only the
+// TTLs offered by the API are accepted; zero respects the origin's headers.
+//
+// The edge cache TTL is set through a cache rule instead: a
+// RulesetRuleActionSetCacheSettings rule in the
+// RulesetPhaseHTTPRequestCacheSettings phase with an EdgeTTL.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-browser-cache-ttl-setting
+func (api *API) SetBrowserCacheTTL(zoneID string, seconds int) (int, error) {
+	if !containsInt(browserCacheTTLs, seconds) {
+		return 0, errors.Errorf("invalid browser cache TTL %d: must be one of %v", seconds, browserCacheTTLs)
+	}
+
+	s, err := api.updateZoneSetting(zoneID, "browser_cache_ttl", seconds)
+	if err != nil {
+		return 0, err
+	}
+	updated, _ := s.Value.(float64)
+	return int(updated), nil
+}
+
+// SetAlwaysUseHTTPS toggles redirecting all plain HTTP requests of the given
+// zone to HTTPS and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-always-use-https-setting
+func (api *API) SetAlwaysUseHTTPS(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "always_use_https", on)
+}
+
+// SetAutomaticHTTPSRewrites toggles rewriting http:// links in the HTML
+// served by the given zone to https:// and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-automatic-https-rewrites-setting
+func (api *API) SetAutomaticHTTPSRewrites(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "automatic_https_rewrites", on)
+}
+
+// DevelopmentMode reports whether development mode is on for the given zone,
+// and if so how many seconds remain before it is switched off automatically.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-development-mode-setting
+func (api *API) DevelopmentMode(zoneID string) (bool, int, error) {
+	s, err := api.zoneSetting(zoneID, "development_mode")
+	if err != nil {
+		return false, 0, err
+	}
+	return s.Value == "on", s.TimeRemaining, nil
+}
+
+// SetDevelopmentMode toggles development mode for the given zone, bypassing
+// the cache for three hours when switched on. It returns the resulting state
+// and remaining time as DevelopmentMode does.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-development-mode-setting
+func (api *API) SetDevelopmentMode(zoneID string, on bool) (bool, int, error) {
+	s, err := api.updateZoneSetting(zoneID, "development_mode", onOff(on))
+	if err != nil {
+		return false, 0, err
+	}
+	return s.Value == "on", s.TimeRemaining, nil
+}
+
+// SetZeroRTT toggles 0-RTT session resumption for TLS 1.3 connections to the
+// given zone and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-0-rtt-session-resumption-setting
+func (api *API) SetZeroRTT(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "0rtt", on)
+}
+
+// SetHTTP3 toggles HTTP/3 (QUIC) support for the given zone and returns
+// whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-http3-setting
+func (api *API) SetHTTP3(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "http3", on)
+}
+
+// SetWebSockets toggles proxying of WebSocket connections for the given zone
+// and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-websockets-setting
+func (api *API) SetWebSockets(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "websockets", on)
+}
+
+// SetBrotli toggles Brotli compression of responses from the given zone and
+// returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-brotli-setting
+func (api *API) SetBrotli(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "brotli", on)
+}
+
+// Polish image optimization levels.
+const (
+	PolishOff      = "off"
+	PolishLossless = "lossless"
+	PolishLossy    = "lossy"
+)
+
+var polishLevels = []string{PolishOff, PolishLossless, PolishLossy}
+
+// SetPolish changes the Polish image optimization level of the given zone and
+// returns the updated level. The level must be one of off, lossless or lossy.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-polish-setting
+func (api *API) SetPolish(zoneID, level string) (string, error) {
+	if !containsString(polishLevels, level) {
+		return "", errors.Errorf("invalid polish level %q: must be one of %s", level, strings.Join(polishLevels, ", "))
+	}
+
+	s, err := api.updateZoneSetting(zoneID, "polish", level)
+	if err != nil {
+		return "", err
+	}
+	updated, _ := s.Value.(string)
+	return updated, nil
+}
+
+// SetWebP toggles conversion of images to WebP by Polish for browsers which
+// support it, and returns whether it is now on. It has no effect while Polish
+// is off.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-webp-setting
+func (api *API) SetWebP(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "webp", on)
+}
+
+// SetMirage toggles Mirage image loading optimization for mobile devices on
+// the given zone and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-mirage-setting
+func (api *API) SetMirage(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "mirage", on)
+}
+
+// SetEarlyHints toggles sending 103 Early Hints responses built from the Link
+// headers of cached responses on the given zone and returns whether it is now
+// on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-early-hints-setting
+func (api *API) SetEarlyHints(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "early_hints", on)
+}
+
+// SetRocketLoader toggles Rocket Loader, which defers loading the JavaScript
+// of pages, on the given zone and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-rocket-loader-setting
+func (api *API) SetRocketLoader(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "rocket_loader", on)
+}
+
+// SetFonts toggles Cloudflare Fonts, which serves Google Fonts from the zone's
+// own hostname, and returns whether it is now on.
+//
+// API reference: https://developers.cloudflare.com/speed/optimization/content/fonts/
+func (api *API) SetFonts(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "fonts", on)
+}
+
+// SetCrawlerHints toggles Crawler Hints, which tell search engines when the
+// content of the given zone changed, and returns whether it is now on.
+//
+// API reference: https://developers.cloudflare.com/cache/advanced-configuration/crawler-hints/
+func (api *API) SetCrawlerHints(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "crawlhints", on)
+}
+
+// SetAlwaysOnline toggles Always Online, which serves cached pages while the
+// origin of the given zone is unreachable, and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-always-online-setting
+func (api *API) SetAlwaysOnline(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "always_online", on)
+}
+
+// maxHSTSMaxAge is the longest HSTS max-age, in seconds, accepted by the
+// API: one year.
+const maxHSTSMaxAge = 31536000
+
+// HSTSConfig holds the HTTP Strict Transport Security header sent for a zone.
+// MaxAge is in seconds. NoSniff additionally sends the
+// "X-Content-Type-Options: nosniff" header.
+type HSTSConfig struct {
+	Enabled           bool `json:"enabled"`
+	MaxAge            int  `json:"max_age"`
+	IncludeSubdomains bool `json:"include_subdomains"`
+	Preload           bool `json:"preload"`
+	NoSniff           bool `json:"nosniff"`
+}
+
+// validate checks the configuration against the limits of the API and the
+// requirements of browser preload lists.
+func (c HSTSConfig) validate() error {
+	if c.MaxAge < 0 || c.MaxAge > maxHSTSMaxAge {
+		return errors.Errorf("invalid HSTS max age %d: must be between 0 and %d", c.MaxAge, maxHSTSMaxAge)
+	}
+	if c.Preload && (!c.IncludeSubdomains || c.MaxAge < maxHSTSMaxAge) {
+		return errors.Errorf("HSTS preload requires include_subdomains and a max age of %d", maxHSTSMaxAge)
+	}
+	return nil
+}
+
+// SetHSTS changes the HTTP Strict Transport Security header of the given zone
+// and returns the updated configuration. The configuration is validated
+// before a request is made.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-security-header-hsts-setting
+func (api *API) SetHSTS(zoneID string, config HSTSConfig) (HSTSConfig, error) {
+	if err := config.validate(); err != nil {
+		return HSTSConfig{}, err
+	}
+
+	s, err := api.updateZoneSetting(zoneID, "security_header", struct {
+		StrictTransportSecurity HSTSConfig `json:"strict_transport_security"`
+	}{config})
+	if err != nil {
+		return HSTSConfig{}, err
+	}
+	var updated struct {
+		StrictTransportSecurity HSTSConfig `json:"strict_transport_security"`
+	}
+	if err := decodeZoneSettingValue(s, &updated); err != nil {
+		return HSTSConfig{}, err
+	}
+	return updated.StrictTransportSecurity, nil
+}
+
+// SetNEL toggles Network Error Logging, which has browsers report connection
+// failures to the given zone, and returns whether it is now enabled.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-network-error-logging-setting
+func (api *API) SetNEL(zoneID string, on bool) (bool, error) {
+	s, err := api.updateZoneSetting(zoneID, "nel", struct {
+		Enabled bool `json:"enabled"`
+	}{on})
+	if err != nil {
+		return false, err
+	}
+	var updated struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := decodeZoneSettingValue(s, &updated); err != nil {
+		return false, err
+	}
+	return updated.Enabled, nil
+}
+
+// originMaxHTTPVersions are the HTTP versions Cloudflare can use to connect
+// to the origin.
+var originMaxHTTPVersions = []string{"1", "2"}
+
+// ZoneOriginMaxHTTPVersion returns the highest HTTP version, "1" or "2",
+// used to connect to the origin of the given zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-origin-max-http-version-setting
+func (api *API) ZoneOriginMaxHTTPVersion(zoneID string) (string, error) {
+	s, err := api.zoneSetting(zoneID, "origin_max_http_version")
+	if err != nil {
+		return "", err
+	}
+	version, _ := s.Value.(string)
+	return version, nil
+}
+
+// SetOriginMaxHTTPVersion changes the highest HTTP version used to connect to
+// the origin of the given zone and returns the updated version. The version
+// must be "1" or "2".
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-origin-max-http-version-setting
+func (api *API) SetOriginMaxHTTPVersion(zoneID, version string) (string, error) {
+	if !containsString(originMaxHTTPVersions, version) {
+		return "", errors.Errorf("invalid origin max HTTP version %q: must be one of %s", version, strings.Join(originMaxHTTPVersions, ", "))
+	}
+
+	s, err := api.updateZoneSetting(zoneID, "origin_max_http_version", version)
+	if err != nil {
+		return "", err
+	}
+	updated, _ := s.Value.(string)
+	return updated, nil
+}
+
+// ZoneOriginErrorPagePassThru returns whether error pages served by the
+// origin of the given zone are passed through instead of Cloudflare's.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-enable-error-pages-on-setting
+func (api *API) ZoneOriginErrorPagePassThru(zoneID string) (bool, error) {
+	s, err := api.zoneSetting(zoneID, "origin_error_page_pass_thru")
+	if err != nil {
+		return false, err
+	}
+	return s.Value == "on", nil
+}
+
+// SetOriginErrorPagePassThru toggles passing through the error pages served
+// by the origin of the given zone and returns whether it is now on.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-enable-error-pages-on-setting
+func (api *API) SetOriginErrorPagePassThru(zoneID string, on bool) (bool, error) {
+	return api.toggleZoneSetting(zoneID, "origin_error_page_pass_thru", on)
+}
+
+// toggleZoneSetting switches a named on/off setting of the given zone. A
+// value other than "on" or "off" in the response is reported as an error.
+func (api *API) toggleZoneSetting(zoneID, name string, on bool) (bool, error) {
+	s, err := api.updateZoneSetting(zoneID, name, onOff(on))
+	if err != nil {
+		return false, err
+	}
+	switch s.Value {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	}
+	return false, errors.Errorf("unexpected value %v for zone setting %s: must be on or off", s.Value, name)
+}
+
+// zoneSetting fetches a single named setting of the given zone.
+func (api *API) zoneSetting(zoneID, name string) (ZoneSetting, error) {
+	uri := "/zones/" + zoneID + "/settings/" + name
+	res, err := api.makeRequest("GET", uri, nil)
+	if err != nil {
+		return ZoneSetting{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneSettingSingleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ZoneSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// updateZoneSetting changes the value of a single named setting of the given
+// zone.
+func (api *API) updateZoneSetting(zoneID, name string, value interface{}) (ZoneSetting, error) {
+	uri := "/zones/" + zoneID + "/settings/" + name
+	res, err := api.makeRequest("PATCH", uri, struct {
+		Value interface{} `json:"value"`
+	}{value})
+	if err != nil {
+		return ZoneSetting{}, errors.Wrap(err, errMakeRequestError)
+	}
+	var r ZoneSettingSingleResponse
+	if err := api.unmarshal(res, &r); err != nil {
+		return ZoneSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// decodeZoneSettingValue decodes the object value of a setting into v.
+func decodeZoneSettingValue(s ZoneSetting, v interface{}) error {
+	b, err := json.Marshal(s.Value)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
+
+// onOff returns the "on" or "off" string value used by toggle settings.
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -582,3 +583,1120 @@ func TestZoneAnalyticsByColocation(t *testing.T) {
 	_, err = client.ZoneAnalyticsDashboard("bar", ZoneAnalyticsOptions{})
 	assert.Error(t, err)
 }
+
+func TestCreateZone_Partial(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "name": "example.com",
+              "jump_start": false,
+              "type": "partial",
+              "organization": {"id": "01a7362d577a6c3019a474fd6f485823"}
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "023e105f4ecef8ad9ca31a8372d0c353",
+            "name": "example.com",
+            "status": "pending",
+            "paused": false,
+            "type": "partial",
+            "name_servers": [
+              "tony.ns.cloudflare.com",
+              "woz.ns.cloudflare.com"
+            ],
+            "verification_key": "284344499-1084221259"
+          }
+        }`)
+	})
+
+	zone, err := client.CreateZone("example.com", false, Organization{ID: "01a7362d577a6c3019a474fd6f485823"}, ZoneTypePartial)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneTypePartial, zone.Type)
+		assert.Equal(t, []string{"tony.ns.cloudflare.com", "woz.ns.cloudflare.com"}, zone.NameServers)
+		assert.Equal(t, "284344499-1084221259", zone.VerificationKey)
+	}
+}
+
+func TestCreateZone_DefaultType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "example.com", "jump_start": true}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com", "type": "full"}
+        }`)
+	})
+
+	zone, err := client.CreateZone("example.com", true, Organization{}, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneTypeFull, zone.Type)
+	}
+
+	_, err = client.CreateZone("example.com", true, Organization{}, "cname")
+	assert.EqualError(t, err, `invalid zone type "cname": must be one of full, partial, secondary`)
+}
+
+func TestSetZoneSecurityLevel_UnderAttack(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/security_level", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "under_attack"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "security_level",
+            "value": "under_attack",
+            "editable": true,
+            "modified_on": "2014-01-01T05:20:00.12345Z"
+          }
+        }`)
+	})
+
+	level, err := client.SetZoneSecurityLevel("023e105f4ecef8ad9ca31a8372d0c353", SecurityLevelUnderAttack)
+	if assert.NoError(t, err) {
+		assert.Equal(t, SecurityLevelUnderAttack, level)
+	}
+}
+
+func TestSetZoneSecurityLevel_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.SetZoneSecurityLevel("023e105f4ecef8ad9ca31a8372d0c353", "extreme")
+	assert.EqualError(t, err, `invalid security level "extreme": must be one of off, essentially_off, low, medium, high, under_attack`)
+}
+
+func TestSetZoneChallengeTTL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/challenge_ttl", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": 1800}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "challenge_ttl", "value": 1800, "editable": true}
+        }`)
+	})
+
+	ttl, err := client.SetZoneChallengeTTL("023e105f4ecef8ad9ca31a8372d0c353", 1800)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1800, ttl)
+	}
+
+	_, err = client.SetZoneChallengeTTL("023e105f4ecef8ad9ca31a8372d0c353", 60)
+	assert.Error(t, err)
+}
+
+func TestSetAlwaysUseHTTPS(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/always_use_https", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "always_use_https", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetAlwaysUseHTTPS("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetAutomaticHTTPSRewrites_Off(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/automatic_https_rewrites", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "automatic_https_rewrites", "value": "off", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetAutomaticHTTPSRewrites("023e105f4ecef8ad9ca31a8372d0c353", false)
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+}
+
+func TestPurgeEverything_RequiresConfirmation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("purge_cache must not be called without confirmation")
+	})
+
+	_, err := client.PurgeEverything("023e105f4ecef8ad9ca31a8372d0c353", false)
+	assert.Equal(t, ErrPurgeEverythingNotConfirmed, err)
+}
+
+func TestPurgeEverything_Confirmed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"purge_everything": true}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "023e105f4ecef8ad9ca31a8372d0c353"}
+        }`)
+	})
+
+	resp, err := client.PurgeEverything("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, resp.Success)
+	}
+}
+
+func TestPauseZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"paused": true}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com", "paused": true}
+        }`)
+	})
+
+	zone, err := client.PauseZone("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.True(t, zone.Paused)
+	}
+}
+
+func TestUnpauseZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"paused": false}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com", "paused": false}
+        }`)
+	})
+
+	zone, err := client.UnpauseZone("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.False(t, zone.Paused)
+	}
+}
+
+func TestDevelopmentMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/development_mode", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "development_mode", "value": "on", "editable": true, "time_remaining": 3600}
+        }`)
+	})
+
+	on, remaining, err := client.DevelopmentMode("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+		assert.Equal(t, 3600, remaining)
+	}
+}
+
+func TestSetDevelopmentMode_Off(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/development_mode", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "development_mode", "value": "off", "editable": true, "time_remaining": 0}
+        }`)
+	})
+
+	on, remaining, err := client.SetDevelopmentMode("023e105f4ecef8ad9ca31a8372d0c353", false)
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+		assert.Equal(t, 0, remaining)
+	}
+}
+
+func TestSetZeroRTT(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/0rtt", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "0rtt", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetZeroRTT("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetHTTP3(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/http3", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "http3", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetHTTP3("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetWebSockets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/websockets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "websockets", "value": "off", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetWebSockets("023e105f4ecef8ad9ca31a8372d0c353", false)
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+}
+
+func TestSetWebSockets_UnexpectedValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/websockets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "websockets", "value": "maybe"}}`)
+	})
+
+	_, err := client.SetWebSockets("023e105f4ecef8ad9ca31a8372d0c353", false)
+	assert.Error(t, err)
+}
+
+func TestSetPolish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/polish", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "lossless"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "polish", "value": "lossless", "editable": true}
+        }`)
+	})
+
+	level, err := client.SetPolish("023e105f4ecef8ad9ca31a8372d0c353", PolishLossless)
+	if assert.NoError(t, err) {
+		assert.Equal(t, PolishLossless, level)
+	}
+}
+
+func TestSetPolish_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.SetPolish("023e105f4ecef8ad9ca31a8372d0c353", "on")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "must be one of off, lossless, lossy")
+	}
+}
+
+func TestSetBrotli(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/brotli", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "brotli", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetBrotli("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetBrowserCacheTTL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/browser_cache_ttl", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": 14400}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "browser_cache_ttl", "value": 14400, "editable": true}
+        }`)
+	})
+
+	ttl, err := client.SetBrowserCacheTTL("023e105f4ecef8ad9ca31a8372d0c353", 14400)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 14400, ttl)
+	}
+}
+
+func TestSetBrowserCacheTTL_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.SetBrowserCacheTTL("023e105f4ecef8ad9ca31a8372d0c353", 1000)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid browser cache TTL 1000")
+	}
+}
+
+func TestZoneAnalyticsOptions_CheckLimits(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2018-06-30T00:00:00Z")
+	free := ZoneAnalyticsPlanLimits(ZoneRatePlan{LegacyID: "free"})
+
+	recent := now.Add(-24 * time.Hour)
+	assert.NoError(t, ZoneAnalyticsOptions{Since: &recent}.CheckLimits(free, now))
+
+	old := now.Add(-60 * 24 * time.Hour)
+	err := ZoneAnalyticsOptions{Since: &old}.CheckLimits(free, now)
+	if assert.Error(t, err) {
+		assert.Equal(t, "invalid analytics range: since 2018-05-01T00:00:00Z is older than the 30 days retained for the free plan (oldest allowed is 2018-05-31T00:00:00Z)", err.Error())
+	}
+
+	enterprise := ZoneAnalyticsPlanLimits(ZoneRatePlan{LegacyID: "enterprise"})
+	assert.NoError(t, ZoneAnalyticsOptions{Since: &old}.CheckLimits(enterprise, now))
+
+	// unknown plans get the limits of the free plan
+	assert.Equal(t, free, ZoneAnalyticsPlanLimits(ZoneRatePlan{LegacyID: "partners_pro"}))
+	assert.Equal(t, []string{"1min", "15min", "hour", "day"}, enterprise.Granularities)
+}
+
+func TestZoneAnalyticsOptions_Clamp(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2018-06-30T00:00:00Z")
+	free := ZoneAnalyticsPlanLimits(ZoneRatePlan{LegacyID: "free"})
+
+	old := now.Add(-60 * 24 * time.Hour)
+	clamped := ZoneAnalyticsOptions{Since: &old}.Clamp(free, now)
+	assert.Equal(t, "2018-05-31T00:00:00Z", clamped.Since.Format(time.RFC3339))
+	assert.Equal(t, "2018-05-01T00:00:00Z", old.Format(time.RFC3339))
+	assert.NoError(t, clamped.CheckLimits(free, now))
+}
+
+func TestZoneAnalyticsDashboard_InvalidRange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since, _ := time.Parse(time.RFC3339, "2015-01-02T12:23:00Z")
+	until, _ := time.Parse(time.RFC3339, "2015-01-01T12:23:00Z")
+	_, err := client.ZoneAnalyticsDashboard("foo", ZoneAnalyticsOptions{Since: &since, Until: &until})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "since 2015-01-02T12:23:00Z is not before until 2015-01-01T12:23:00Z")
+	}
+}
+
+func TestZoneAnalyticsDashboard_EnforceRetention(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "023e105f4ecef8ad9ca31a8372d0c353",
+            "name": "example.com",
+            "plan": {"id": "0feeeeeeeeeeeeeeeeeeeeeeeeeeeeee", "name": "Free Website", "legacy_id": "free"}
+          }
+        }`)
+	})
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/analytics/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no analytics request for a range the plan does not retain")
+	})
+
+	since := time.Now().Add(-60 * 24 * time.Hour)
+	_, err := client.ZoneAnalyticsDashboard("023e105f4ecef8ad9ca31a8372d0c353", ZoneAnalyticsOptions{
+		Since:            &since,
+		EnforceRetention: true,
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "is older than the 30 days retained for the free plan")
+	}
+}
+
+func TestZoneAnalyticsLimits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "023e105f4ecef8ad9ca31a8372d0c353",
+            "name": "example.com",
+            "plan": {"id": "94f3b7b768b0458b56d2cac4fe5ec0f9", "name": "Enterprise Website", "legacy_id": "enterprise"}
+          }
+        }`)
+	})
+
+	limits, err := client.ZoneAnalyticsLimits("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "enterprise", limits.Plan)
+		assert.Equal(t, 365*24*time.Hour, limits.Retention)
+	}
+}
+
+func TestSetOriginMaxHTTPVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/origin_max_http_version", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "2"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "origin_max_http_version", "value": "2", "editable": true}
+        }`)
+	})
+
+	version, err := client.SetOriginMaxHTTPVersion("023e105f4ecef8ad9ca31a8372d0c353", "2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2", version)
+	}
+}
+
+func TestSetOriginMaxHTTPVersion_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.SetOriginMaxHTTPVersion("023e105f4ecef8ad9ca31a8372d0c353", "3")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `invalid origin max HTTP version "3": must be one of 1, 2`)
+	}
+}
+
+func TestZoneOriginMaxHTTPVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/origin_max_http_version", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "origin_max_http_version", "value": "1", "editable": true}
+        }`)
+	})
+
+	version, err := client.ZoneOriginMaxHTTPVersion("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1", version)
+	}
+}
+
+func TestSetOriginErrorPagePassThru(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/origin_error_page_pass_thru", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "origin_error_page_pass_thru", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetOriginErrorPagePassThru("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestZoneOriginErrorPagePassThru(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/origin_error_page_pass_thru", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "origin_error_page_pass_thru", "value": "off", "editable": true}
+        }`)
+	})
+
+	on, err := client.ZoneOriginErrorPagePassThru("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+}
+
+func TestZoneAvailablePlans(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/available_plans", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {
+              "id": "0feeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+              "name": "Free Website",
+              "price": 0,
+              "currency": "USD",
+              "frequency": "",
+              "is_subscribed": true,
+              "can_subscribe": false,
+              "legacy_id": "free"
+            },
+            {
+              "id": "94f3b7b768b0458b56d2cac4fe5ec0f9",
+              "name": "Business Website",
+              "price": 200,
+              "currency": "USD",
+              "frequency": "monthly",
+              "is_subscribed": false,
+              "can_subscribe": true,
+              "legacy_id": "business"
+            }
+          ],
+          "result_info": {"page": 1, "per_page": 20, "count": 2, "total_count": 2}
+        }`)
+	})
+
+	want := []ZoneRatePlan{
+		{
+			ID:           "0feeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+			Name:         "Free Website",
+			Currency:     "USD",
+			IsSubscribed: true,
+			LegacyID:     "free",
+		},
+		{
+			ID:           "94f3b7b768b0458b56d2cac4fe5ec0f9",
+			Name:         "Business Website",
+			Price:        200,
+			Currency:     "USD",
+			Frequency:    "monthly",
+			CanSubscribe: true,
+			LegacyID:     "business",
+		},
+	}
+
+	plans, err := client.ZoneAvailablePlans("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, plans)
+	}
+}
+
+func TestZoneDetails_PlanEntitlements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "023e105f4ecef8ad9ca31a8372d0c353",
+            "name": "example.com",
+            "plan": {
+              "id": "94f3b7b768b0458b56d2cac4fe5ec0f9",
+              "name": "Business Website",
+              "legacy_id": "business",
+              "is_subscribed": true,
+              "can_subscribe": false
+            }
+          }
+        }`)
+	})
+
+	z, err := client.ZoneDetails("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "business", z.Plan.LegacyID)
+		assert.True(t, z.Plan.IsSubscribed)
+		assert.False(t, z.Plan.CanSubscribe)
+	}
+}
+
+func TestZoneSettingsMap(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": [
+            {"id": "always_use_https", "value": "on", "editable": true, "modified_on": "2018-01-01T05:20:00.12345Z"},
+            {"id": "browser_cache_ttl", "value": 14400, "editable": true},
+            {"id": "security_level", "value": "medium", "editable": true},
+            {"id": "http2", "value": "on", "editable": false},
+            {"id": "minify", "value": {"css": "on", "html": "off", "js": "off"}, "editable": true}
+          ]
+        }`)
+	})
+
+	settings, err := client.ZoneSettingsMap("023e105f4ecef8ad9ca31a8372d0c353")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 5, len(settings))
+
+	on, ok := settings.Bool("always_use_https")
+	assert.True(t, ok)
+	assert.True(t, on)
+	assert.Equal(t, "2018-01-01T05:20:00.12345Z", settings["always_use_https"].ModifiedOn)
+
+	ttl, ok := settings.Int("browser_cache_ttl")
+	assert.True(t, ok)
+	assert.Equal(t, 14400, ttl)
+
+	level, ok := settings.String("security_level")
+	assert.True(t, ok)
+	assert.Equal(t, "medium", level)
+
+	minify, ok := settings.Value("minify")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"css": "on", "html": "off", "js": "off"}, minify)
+
+	assert.True(t, settings.Editable("always_use_https"))
+	assert.False(t, settings.Editable("http2"))
+
+	_, ok = settings.Bool("security_level")
+	assert.False(t, ok)
+	_, ok = settings.String("browser_cache_ttl")
+	assert.False(t, ok)
+	_, ok = settings.Value("websockets")
+	assert.False(t, ok)
+	assert.False(t, settings.Editable("websockets"))
+}
+
+func TestSetEarlyHints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/early_hints", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "early_hints", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetEarlyHints("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetRocketLoader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/rocket_loader", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "rocket_loader", "value": "off", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetRocketLoader("023e105f4ecef8ad9ca31a8372d0c353", false)
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+}
+
+func TestSetFonts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/fonts", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "fonts", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetFonts("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetRocketLoader_UnexpectedValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/rocket_loader", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "rocket_loader", "value": "manual", "editable": true}
+        }`)
+	})
+
+	_, err := client.SetRocketLoader("023e105f4ecef8ad9ca31a8372d0c353", true)
+	assert.Error(t, err)
+}
+
+func TestSetCrawlerHints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/crawlhints", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "crawlhints", "value": "on", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetCrawlerHints("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, on)
+	}
+}
+
+func TestSetAlwaysOnline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/always_online", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "always_online", "value": "off", "editable": true}
+        }`)
+	})
+
+	on, err := client.SetAlwaysOnline("023e105f4ecef8ad9ca31a8372d0c353", false)
+	if assert.NoError(t, err) {
+		assert.False(t, on)
+	}
+}
+
+func TestSetHSTS(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/security_header", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+              "value": {
+                "strict_transport_security": {
+                  "enabled": true,
+                  "max_age": 31536000,
+                  "include_subdomains": true,
+                  "preload": true,
+                  "nosniff": true
+                }
+              }
+            }`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "security_header",
+            "value": {
+              "strict_transport_security": {
+                "enabled": true,
+                "max_age": 31536000,
+                "include_subdomains": true,
+                "preload": true,
+                "nosniff": true
+              }
+            },
+            "editable": true
+          }
+        }`)
+	})
+
+	config := HSTSConfig{Enabled: true, MaxAge: 31536000, IncludeSubdomains: true, Preload: true, NoSniff: true}
+	updated, err := client.SetHSTS("023e105f4ecef8ad9ca31a8372d0c353", config)
+	if assert.NoError(t, err) {
+		assert.Equal(t, config, updated)
+	}
+}
+
+func TestSetHSTS_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.SetHSTS("023e105f4ecef8ad9ca31a8372d0c353", HSTSConfig{Enabled: true, MaxAge: -1})
+	assert.EqualError(t, err, "invalid HSTS max age -1: must be between 0 and 31536000")
+
+	_, err = client.SetHSTS("023e105f4ecef8ad9ca31a8372d0c353", HSTSConfig{Enabled: true, MaxAge: 31536001})
+	assert.EqualError(t, err, "invalid HSTS max age 31536001: must be between 0 and 31536000")
+
+	_, err = client.SetHSTS("023e105f4ecef8ad9ca31a8372d0c353", HSTSConfig{Enabled: true, MaxAge: 31536000, Preload: true})
+	assert.EqualError(t, err, "HSTS preload requires include_subdomains and a max age of 31536000")
+
+	_, err = client.SetHSTS("023e105f4ecef8ad9ca31a8372d0c353", HSTSConfig{Enabled: true, MaxAge: 86400, IncludeSubdomains: true, Preload: true})
+	assert.EqualError(t, err, "HSTS preload requires include_subdomains and a max age of 31536000")
+}
+
+func TestSetNEL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/nel", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": {"enabled": true}}`, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {"id": "nel", "value": {"enabled": true}, "editable": true}
+        }`)
+	})
+
+	enabled, err := client.SetNEL("023e105f4ecef8ad9ca31a8372d0c353", true)
+	if assert.NoError(t, err) {
+		assert.True(t, enabled)
+	}
+}